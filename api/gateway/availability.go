@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -54,8 +55,8 @@ func (h *Handler) handleHeightAvailabilityRequest(w http.ResponseWriter, r *http
 	}
 
 	err = h.share.SharesAvailable(r.Context(), header.DAH)
-	switch err {
-	case nil:
+	switch {
+	case err == nil:
 		availResp.Available = true
 		resp, err := json.Marshal(availResp)
 		if err != nil {
@@ -66,7 +67,7 @@ func (h *Handler) handleHeightAvailabilityRequest(w http.ResponseWriter, r *http
 		if werr != nil {
 			log.Errorw("serving request", "endpoint", heightAvailabilityEndpoint, "err", err)
 		}
-	case share.ErrNotAvailable:
+	case errors.Is(err, share.ErrNotAvailable):
 		availResp.Available = false
 		resp, err := json.Marshal(availResp)
 		if err != nil {
@@ -13,6 +13,12 @@ func (h *Handler) RegisterEndpoints(rpc *Server) {
 	rpc.RegisterHandlerFunc(submitTxEndpoint, h.handleSubmitTx, http.MethodPost)
 	rpc.RegisterHandlerFunc(submitPFBEndpoint, h.handleSubmitPFB, http.MethodPost)
 
+	// grants
+	rpc.RegisterHandlerFunc(grantFeeEndpoint, h.handleGrantFee, http.MethodPost)
+	rpc.RegisterHandlerFunc(revokeFeeEndpoint, h.handleRevokeFee, http.MethodPost)
+	rpc.RegisterHandlerFunc(grantPFBEndpoint, h.handleGrantPFB, http.MethodPost)
+	rpc.RegisterHandlerFunc(revokePFBEndpoint, h.handleRevokePFB, http.MethodPost)
+
 	// staking queries
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", queryDelegationEndpoint, addrKey), h.handleQueryDelegation,
 		http.MethodGet)
@@ -20,8 +26,16 @@ func (h *Handler) RegisterEndpoints(rpc *Server) {
 		http.MethodGet)
 	rpc.RegisterHandlerFunc(queryRedelegationsEndpoint, h.handleQueryRedelegations,
 		http.MethodPost)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", queryValidatorEndpoint, addrKey), h.handleQueryValidator,
+		http.MethodGet)
+
+	// account queries
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", queryAccountEndpoint, addrKey), h.handleQueryAccount,
+		http.MethodGet)
+	rpc.RegisterHandlerFunc(totalSupplyEndpoint, h.handleTotalSupply, http.MethodGet)
 
 	// share endpoints
+	rpc.RegisterHandlerFunc(namespacedSharesEndpoint, h.handleSharesByNamespacesRequest, http.MethodGet)
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/height/{%s}", namespacedSharesEndpoint, nIDKey, heightKey),
 		h.handleSharesByNamespaceRequest, http.MethodGet)
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", namespacedSharesEndpoint, nIDKey),
@@ -30,6 +44,18 @@ func (h *Handler) RegisterEndpoints(rpc *Server) {
 		h.handleDataByNamespaceRequest, http.MethodGet)
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", namespacedDataEndpoint, nIDKey),
 		h.handleDataByNamespaceRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/height/{%s}", rowSharesEndpoint, indexKey, heightKey),
+		h.handleRowSharesRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", rowSharesEndpoint, indexKey),
+		h.handleRowSharesRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/height/{%s}", columnSharesEndpoint, indexKey, heightKey),
+		h.handleColumnSharesRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", columnSharesEndpoint, indexKey),
+		h.handleColumnSharesRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/{%s}/height/{%s}", rangeSharesEndpoint, startKey, endKey, heightKey),
+		h.handleRangeSharesRequest, http.MethodGet)
+	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}/{%s}", rangeSharesEndpoint, startKey, endKey),
+		h.handleRangeSharesRequest, http.MethodGet)
 
 	// DAS endpoints
 	rpc.RegisterHandlerFunc(fmt.Sprintf("%s/{%s}", heightAvailabilityEndpoint, heightKey),
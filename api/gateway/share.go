@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/nmt/namespace"
+	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
@@ -20,9 +22,20 @@ import (
 const (
 	namespacedSharesEndpoint = "/namespaced_shares"
 	namespacedDataEndpoint   = "/namespaced_data"
+	rowSharesEndpoint        = "/row_shares"
+	columnSharesEndpoint     = "/column_shares"
+	rangeSharesEndpoint      = "/range_shares"
 )
 
-var nIDKey = "nid"
+// errHeightAboveHead is returned when the requested height is not synced yet.
+var errHeightAboveHead = errors.New("requested height is above the local chain head")
+
+var (
+	nIDKey   = "nid"
+	indexKey = "index"
+	startKey = "start"
+	endKey   = "end"
+)
 
 // NamespacedSharesResponse represents the response to a
 // SharesByNamespace request.
@@ -31,6 +44,14 @@ type NamespacedSharesResponse struct {
 	Height uint64        `json:"height"`
 }
 
+// NamespacedSharesBatchResponse represents the response to a
+// SharesByNamespaces request. Shares are keyed by the hex encoded
+// namespace ID, omitting the namespaces that were not found.
+type NamespacedSharesBatchResponse struct {
+	Shares map[string][]share.Share `json:"shares"`
+	Height uint64                   `json:"height"`
+}
+
 // NamespacedDataResponse represents the response to a
 // DataByNamespace request.
 type NamespacedDataResponse struct {
@@ -38,6 +59,20 @@ type NamespacedDataResponse struct {
 	Height uint64   `json:"height"`
 }
 
+// AxisSharesResponse represents the response to a
+// row or column shares request.
+type AxisSharesResponse struct {
+	Shares *share.AxisShares `json:"shares"`
+	Height uint64            `json:"height"`
+}
+
+// RangeSharesResponse represents the response to a
+// range shares request.
+type RangeSharesResponse struct {
+	Shares share.RangeShares `json:"shares"`
+	Height uint64            `json:"height"`
+}
+
 func (h *Handler) handleSharesByNamespaceRequest(w http.ResponseWriter, r *http.Request) {
 	height, nID, err := parseGetByNamespaceArgs(r)
 	if err != nil {
@@ -46,7 +81,7 @@ func (h *Handler) handleSharesByNamespaceRequest(w http.ResponseWriter, r *http.
 	}
 	shares, headerHeight, err := h.getShares(r.Context(), height, nID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, namespacedSharesEndpoint, err)
+		writeError(w, headerErrorStatus(err), namespacedSharesEndpoint, err)
 		return
 	}
 	resp, err := json.Marshal(&NamespacedSharesResponse{
@@ -63,6 +98,40 @@ func (h *Handler) handleSharesByNamespaceRequest(w http.ResponseWriter, r *http.
 	}
 }
 
+func (h *Handler) handleSharesByNamespacesRequest(w http.ResponseWriter, r *http.Request) {
+	height, nIDs, err := parseGetByNamespacesArgs(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, namespacedSharesEndpoint, err)
+		return
+	}
+	header, err := h.getHeader(r.Context(), height)
+	if err != nil {
+		writeError(w, headerErrorStatus(err), namespacedSharesEndpoint, err)
+		return
+	}
+	shares, err := h.share.GetSharesByNamespaces(r.Context(), header.DAH, nIDs)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, namespacedSharesEndpoint, err)
+		return
+	}
+	resp := &NamespacedSharesBatchResponse{
+		Shares: make(map[string][]share.Share, len(shares)),
+		Height: uint64(header.Height()),
+	}
+	for nID, rows := range shares {
+		resp.Shares[nID] = rows.Flatten()
+	}
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, namespacedSharesEndpoint, err)
+		return
+	}
+	_, err = w.Write(bs)
+	if err != nil {
+		log.Errorw("serving request", "endpoint", namespacedSharesEndpoint, "err", err)
+	}
+}
+
 func (h *Handler) handleDataByNamespaceRequest(w http.ResponseWriter, r *http.Request) {
 	height, nID, err := parseGetByNamespaceArgs(r)
 	if err != nil {
@@ -71,7 +140,7 @@ func (h *Handler) handleDataByNamespaceRequest(w http.ResponseWriter, r *http.Re
 	}
 	shares, headerHeight, err := h.getShares(r.Context(), height, nID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, namespacedDataEndpoint, err)
+		writeError(w, headerErrorStatus(err), namespacedDataEndpoint, err)
 		return
 	}
 	data, err := dataFromShares(shares)
@@ -93,27 +162,102 @@ func (h *Handler) handleDataByNamespaceRequest(w http.ResponseWriter, r *http.Re
 	}
 }
 
-func (h *Handler) getShares(ctx context.Context, height uint64, nID namespace.ID) ([]share.Share, int64, error) {
-	// get header
-	var (
-		err    error
-		header *header.ExtendedHeader
-	)
+func (h *Handler) handleRowSharesRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAxisSharesRequest(w, r, rowSharesEndpoint, rsmt2d.Row)
+}
 
-	//TODO: change this to NetworkHead once the adjacency in the store is fixed.
-	header, err = h.header.LocalHead(ctx)
+func (h *Handler) handleColumnSharesRequest(w http.ResponseWriter, r *http.Request) {
+	h.handleAxisSharesRequest(w, r, columnSharesEndpoint, rsmt2d.Col)
+}
+
+func (h *Handler) handleAxisSharesRequest(w http.ResponseWriter, r *http.Request, endpoint string, axis rsmt2d.Axis) {
+	height, err := parseHeightArg(r)
 	if err != nil {
-		return nil, 0, err
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	idx, err := strconv.Atoi(mux.Vars(r)[indexKey])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
 	}
+	header, err := h.getHeader(r.Context(), height)
+	if err != nil {
+		writeError(w, headerErrorStatus(err), endpoint, err)
+		return
+	}
+	err = share.ValidateAxis(header.DAH, idx, axis)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	shares, err := h.share.GetRow(r.Context(), header.DAH, idx, axis)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, endpoint, err)
+		return
+	}
+	resp, err := json.Marshal(&AxisSharesResponse{
+		Shares: shares,
+		Height: uint64(header.Height()),
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, endpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("serving request", "endpoint", endpoint, "err", err)
+	}
+}
 
-	if height > 0 {
-		if storeHeight := uint64(header.Height()); storeHeight < height {
-			return nil, 0, fmt.Errorf(
-				"current head local chain head: %d is lower than requested height: %d"+
-					" give header sync some time and retry later", storeHeight, height)
-		}
-		header, err = h.header.GetByHeight(ctx, height)
+func (h *Handler) handleRangeSharesRequest(w http.ResponseWriter, r *http.Request) {
+	height, err := parseHeightArg(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, rangeSharesEndpoint, err)
+		return
 	}
+	vars := mux.Vars(r)
+	start, err := strconv.Atoi(vars[startKey])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, rangeSharesEndpoint, err)
+		return
+	}
+	end, err := strconv.Atoi(vars[endKey])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, rangeSharesEndpoint, err)
+		return
+	}
+	header, err := h.getHeader(r.Context(), height)
+	if err != nil {
+		writeError(w, headerErrorStatus(err), rangeSharesEndpoint, err)
+		return
+	}
+	err = share.ValidateRange(header.DAH, start, end)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, rangeSharesEndpoint, err)
+		return
+	}
+	shares, err := h.share.GetRange(r.Context(), header.DAH, start, end)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, rangeSharesEndpoint, err)
+		return
+	}
+	resp, err := json.Marshal(&RangeSharesResponse{
+		Shares: shares,
+		Height: uint64(header.Height()),
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, rangeSharesEndpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("serving request", "endpoint", rangeSharesEndpoint, "err", err)
+	}
+}
+
+func (h *Handler) getShares(ctx context.Context, height uint64, nID namespace.ID) ([]share.Share, int64, error) {
+	header, err := h.getHeader(ctx, height)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -122,6 +266,25 @@ func (h *Handler) getShares(ctx context.Context, height uint64, nID namespace.ID
 	return shares.Flatten(), header.Height(), err
 }
 
+// getHeader gets the header at the given height, or the local head if the height is zero.
+func (h *Handler) getHeader(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
+	//TODO: change this to NetworkHead once the adjacency in the store is fixed.
+	header, err := h.header.LocalHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if height > 0 {
+		if storeHeight := uint64(header.Height()); storeHeight < height {
+			return nil, fmt.Errorf(
+				"%w: current head local chain head: %d is lower than requested height: %d"+
+					" give header sync some time and retry later", errHeightAboveHead, storeHeight, height)
+		}
+		return h.header.GetByHeight(ctx, height)
+	}
+	return header, nil
+}
+
 func dataFromShares(input []share.Share) (data [][]byte, err error) {
 	sequences, err := shares.ParseShares(input)
 	if err != nil {
@@ -138,19 +301,71 @@ func dataFromShares(input []share.Share) (data [][]byte, err error) {
 }
 
 func parseGetByNamespaceArgs(r *http.Request) (height uint64, nID namespace.ID, err error) {
-	vars := mux.Vars(r)
-	// if a height was given, parse it, otherwise get namespaced shares/data from the latest header
-	if strHeight, ok := vars[heightKey]; ok {
+	height, err = parseHeightArg(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	hexNID := mux.Vars(r)[nIDKey]
+	nID, err = parseNamespaceID(hexNID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return height, nID, nil
+}
+
+// parseGetByNamespacesArgs parses the hex encoded namespace IDs given as repeated query
+// parameters and the optional height query parameter.
+func parseGetByNamespacesArgs(r *http.Request) (height uint64, nIDs []namespace.ID, err error) {
+	query := r.URL.Query()
+	if strHeight := query.Get(heightKey); strHeight != "" {
 		height, err = strconv.ParseUint(strHeight, 10, 64)
 		if err != nil {
 			return 0, nil, err
 		}
 	}
-	hexNID := vars[nIDKey]
-	nID, err = hex.DecodeString(hexNID)
+
+	hexNIDs := query[nIDKey]
+	if len(hexNIDs) == 0 {
+		return 0, nil, fmt.Errorf("at least one %q query parameter is required", nIDKey)
+	}
+	nIDs = make([]namespace.ID, len(hexNIDs))
+	for i, hexNID := range hexNIDs {
+		nIDs[i], err = parseNamespaceID(hexNID)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return height, nIDs, nil
+}
+
+// parseHeightArg parses the height if it was given, otherwise zero height is returned to signal
+// the request is for the latest header.
+func parseHeightArg(r *http.Request) (uint64, error) {
+	strHeight, ok := mux.Vars(r)[heightKey]
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseUint(strHeight, 10, 64)
+}
+
+// parseNamespaceID decodes the hex-encoded namespace ID and checks its size.
+func parseNamespaceID(hexNID string) (namespace.ID, error) {
+	nID, err := hex.DecodeString(hexNID)
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
+	if len(nID) != share.NamespaceSize {
+		return nil, fmt.Errorf("expected namespace ID of size %d, got %d", share.NamespaceSize, len(nID))
+	}
+	return nID, nil
+}
 
-	return height, nID, nil
+// headerErrorStatus returns the status code of the response to a request failing to get the header
+// or the data of the header.
+func headerErrorStatus(err error) int {
+	if errors.Is(err, errHeightAboveHead) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
 }
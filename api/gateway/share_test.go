@@ -2,11 +2,21 @@ package gateway
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/nmt/namespace"
+
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
 )
 
 func Test_dataFromShares(t *testing.T) {
@@ -121,3 +131,80 @@ func padShare(share []byte) (paddedShare []byte) {
 func fillShare(share []byte, filler byte) (paddedShare []byte) {
 	return append(share, bytes.Repeat([]byte{filler}, appconsts.ShareSize-len(share))...)
 }
+
+func Test_parseGetByNamespacesArgs(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, namespacedSharesEndpoint+"?nid=0000000000000001&nid=0000000000000002", nil)
+	height, nIDs, err := parseGetByNamespacesArgs(r)
+	require.NoError(t, err)
+	assert.Zero(t, height)
+	assert.Equal(t, []namespace.ID{{0, 0, 0, 0, 0, 0, 0, 1}, {0, 0, 0, 0, 0, 0, 0, 2}}, nIDs)
+
+	r = httptest.NewRequest(http.MethodGet, namespacedSharesEndpoint+"?nid=0000000000000001&height=10", nil)
+	height, nIDs, err = parseGetByNamespacesArgs(r)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, height)
+	assert.Len(t, nIDs, 1)
+
+	r = httptest.NewRequest(http.MethodGet, namespacedSharesEndpoint, nil)
+	_, _, err = parseGetByNamespacesArgs(r)
+	assert.Error(t, err)
+
+	r = httptest.NewRequest(http.MethodGet, namespacedSharesEndpoint+"?nid=zz", nil)
+	_, _, err = parseGetByNamespacesArgs(r)
+	assert.Error(t, err)
+}
+
+func TestShareHandlers_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	headerMod := headerMock.NewMockModule(ctrl)
+	head := headertest.RandExtendedHeader(t)
+	headerMod.EXPECT().LocalHead(gomock.Any()).Return(head, nil).AnyTimes()
+	h := NewHandler(nil, nil, headerMod, nil)
+
+	testCases := []struct {
+		name    string
+		handler http.HandlerFunc
+		vars    map[string]string
+		status  int
+	}{
+		{
+			name:    "malformed namespace",
+			handler: h.handleSharesByNamespaceRequest,
+			vars:    map[string]string{nIDKey: "01"},
+			status:  http.StatusBadRequest,
+		},
+		{
+			name:    "malformed height",
+			handler: h.handleRowSharesRequest,
+			vars:    map[string]string{heightKey: "-1", indexKey: "0"},
+			status:  http.StatusBadRequest,
+		},
+		{
+			name:    "row out of bounds",
+			handler: h.handleRowSharesRequest,
+			vars:    map[string]string{indexKey: "2"},
+			status:  http.StatusBadRequest,
+		},
+		{
+			name:    "invalid range",
+			handler: h.handleRangeSharesRequest,
+			vars:    map[string]string{startKey: "0", endKey: "5"},
+			status:  http.StatusBadRequest,
+		},
+		{
+			name:    "height above head",
+			handler: h.handleColumnSharesRequest,
+			vars:    map[string]string{heightKey: strconv.FormatInt(head.Height()+1, 10), indexKey: "0"},
+			status:  http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), tc.vars)
+			w := httptest.NewRecorder()
+			tc.handler(w, r)
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
@@ -19,6 +19,13 @@ const (
 	queryDelegationEndpoint    = "/query_delegation"
 	queryUnbondingEndpoint     = "/query_unbonding"
 	queryRedelegationsEndpoint = "/query_redelegations"
+	queryValidatorEndpoint     = "/query_validator"
+	queryAccountEndpoint       = "/query_account"
+	totalSupplyEndpoint        = "/total_supply"
+	grantFeeEndpoint           = "/grant_fee"
+	revokeFeeEndpoint          = "/revoke_fee"
+	grantPFBEndpoint           = "/grant_pfb"
+	revokePFBEndpoint          = "/revoke_pfb"
 )
 
 const addrKey = "address"
@@ -40,6 +47,27 @@ type submitPFBRequest struct {
 	Data        string `json:"data"`
 	Fee         int64  `json:"fee"`
 	GasLimit    uint64 `json:"gas_limit"`
+	// FeeGranter optionally sets the account paying the fee from the allowance it granted.
+	FeeGranter string `json:"fee_granter,omitempty"`
+	// Account optionally selects the keyring account the transaction is signed with.
+	Account string `json:"account,omitempty"`
+}
+
+// grantRequest represents a request to grant or revoke a fee allowance
+// or a PayForBlob authorization.
+type grantRequest struct {
+	Grantee string `json:"grantee"`
+	// SpendLimit optionally limits the fee allowance. It is ignored by the other requests.
+	SpendLimit int64  `json:"spend_limit,omitempty"`
+	Fee        int64  `json:"fee"`
+	GasLimit   uint64 `json:"gas_limit"`
+	// Account optionally selects the keyring account granting or revoking.
+	Account string `json:"account,omitempty"`
+}
+
+// txOptions returns the options selecting the account of the request.
+func (req grantRequest) txOptions() *state.TxOptions {
+	return &state.TxOptions{Account: req.Account}
 }
 
 // queryRedelegationsRequest represents a request to query redelegations
@@ -136,9 +164,18 @@ func (h *Handler) handleSubmitPFB(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, submitPFBEndpoint, err)
 		return
 	}
+	var feeGranter state.AccAddress
+	if req.FeeGranter != "" {
+		feeGranter, err = types.AccAddressFromBech32(req.FeeGranter)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, submitPFBEndpoint, err)
+			return
+		}
+	}
 	fee := types.NewInt(req.Fee)
 	// perform request
-	txResp, err := h.state.SubmitPayForBlob(r.Context(), nID, data, fee, req.GasLimit)
+	opts := &state.TxOptions{Account: req.Account, FeeGranter: feeGranter}
+	txResp, err := h.state.SubmitPayForBlobWithOptions(r.Context(), nID, data, fee, req.GasLimit, opts)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, submitPFBEndpoint, err)
 		return
@@ -248,3 +285,148 @@ func (h *Handler) handleQueryRedelegations(w http.ResponseWriter, r *http.Reques
 		log.Errorw("writing response", "endpoint", queryRedelegationsEndpoint, "err", err)
 	}
 }
+
+func (h *Handler) handleQueryValidator(w http.ResponseWriter, r *http.Request) {
+	// read and parse request
+	vars := mux.Vars(r)
+	addrStr, exists := vars[addrKey]
+	if !exists {
+		writeError(w, http.StatusBadRequest, queryValidatorEndpoint, ErrMissingAddress)
+		return
+	}
+
+	// convert address to Address type
+	addr, err := types.ValAddressFromBech32(addrStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, queryValidatorEndpoint, err)
+		return
+	}
+	validator, err := h.state.QueryValidator(r.Context(), addr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, queryValidatorEndpoint, err)
+		return
+	}
+	resp, err := json.Marshal(validator)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, queryValidatorEndpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("writing response", "endpoint", queryValidatorEndpoint, "err", err)
+	}
+}
+
+func (h *Handler) handleQueryAccount(w http.ResponseWriter, r *http.Request) {
+	// read and parse request
+	vars := mux.Vars(r)
+	addrStr, exists := vars[addrKey]
+	if !exists {
+		writeError(w, http.StatusBadRequest, queryAccountEndpoint, ErrMissingAddress)
+		return
+	}
+
+	// convert address to Address type
+	addr, err := types.AccAddressFromBech32(addrStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, queryAccountEndpoint, err)
+		return
+	}
+	account, err := h.state.QueryAccount(r.Context(), addr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, queryAccountEndpoint, err)
+		return
+	}
+	resp, err := json.Marshal(account)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, queryAccountEndpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("writing response", "endpoint", queryAccountEndpoint, "err", err)
+	}
+}
+
+func (h *Handler) handleTotalSupply(w http.ResponseWriter, r *http.Request) {
+	supply, err := h.state.TotalSupply(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, totalSupplyEndpoint, err)
+		return
+	}
+	resp, err := json.Marshal(supply)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, totalSupplyEndpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("writing response", "endpoint", totalSupplyEndpoint, "err", err)
+	}
+}
+
+func (h *Handler) handleGrantFee(w http.ResponseWriter, r *http.Request) {
+	h.handleGrant(w, r, grantFeeEndpoint, func(grantee state.AccAddress, req grantRequest) (*state.TxResponse, error) {
+		return h.state.GrantFee(r.Context(), grantee, types.NewInt(req.SpendLimit), types.NewInt(req.Fee),
+			req.GasLimit, req.txOptions())
+	})
+}
+
+func (h *Handler) handleRevokeFee(w http.ResponseWriter, r *http.Request) {
+	h.handleGrant(w, r, revokeFeeEndpoint, func(grantee state.AccAddress, req grantRequest) (*state.TxResponse, error) {
+		return h.state.RevokeFee(r.Context(), grantee, types.NewInt(req.Fee), req.GasLimit, req.txOptions())
+	})
+}
+
+func (h *Handler) handleGrantPFB(w http.ResponseWriter, r *http.Request) {
+	h.handleGrant(w, r, grantPFBEndpoint, func(grantee state.AccAddress, req grantRequest) (*state.TxResponse, error) {
+		return h.state.GrantPayForBlob(r.Context(), grantee, types.NewInt(req.Fee), req.GasLimit, req.txOptions())
+	})
+}
+
+func (h *Handler) handleRevokePFB(w http.ResponseWriter, r *http.Request) {
+	h.handleGrant(w, r, revokePFBEndpoint, func(grantee state.AccAddress, req grantRequest) (*state.TxResponse, error) {
+		return h.state.RevokePayForBlob(r.Context(), grantee, types.NewInt(req.Fee), req.GasLimit, req.txOptions())
+	})
+}
+
+// handleGrant decodes the grantRequest shared by the grant and revoke endpoints and writes the
+// response of the given submission.
+func (h *Handler) handleGrant(
+	w http.ResponseWriter,
+	r *http.Request,
+	endpoint string,
+	submit func(grantee state.AccAddress, req grantRequest) (*state.TxResponse, error),
+) {
+	// decode request
+	var req grantRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	grantee, err := types.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	// perform request
+	txResp, err := submit(grantee, req)
+	if errors.Is(err, state.ErrNegativeSpendLimit) {
+		writeError(w, http.StatusBadRequest, endpoint, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, endpoint, err)
+		return
+	}
+	resp, err := json.Marshal(txResp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, endpoint, err)
+		return
+	}
+	_, err = w.Write(resp)
+	if err != nil {
+		log.Errorw("writing response", "endpoint", endpoint, "err", err)
+	}
+}
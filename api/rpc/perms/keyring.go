@@ -0,0 +1,139 @@
+package perms
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cristalhq/jwt"
+)
+
+// ErrTokenRevoked is returned when the token has been revoked.
+var ErrTokenRevoked = errors.New("perms: token has been revoked")
+
+// Keyring keeps the secrets tokens are signed and verified with along with the list of revoked
+// tokens. Once the signing secret is rotated, the previous one keeps verifying tokens until its grace
+// window ends, so clients have the time to obtain new tokens.
+type Keyring struct {
+	lk       sync.RWMutex
+	current  jwt.Signer
+	previous []RotatedSigner
+	// revoked maps IDs of revoked tokens to their expiry. Zero expiry means the token never expires.
+	revoked map[string]time.Time
+}
+
+// RotatedSigner is a secret the Keyring was rotated from, that still verifies tokens until
+// ValidUntil.
+type RotatedSigner struct {
+	jwt.Signer
+	ValidUntil time.Time
+}
+
+// NewKeyring constructs a new Keyring signing tokens with the given secret.
+func NewKeyring(current jwt.Signer) *Keyring {
+	return &Keyring{
+		current: current,
+		revoked: make(map[string]time.Time),
+	}
+}
+
+// Signer returns the secret new tokens are signed with.
+func (k *Keyring) Signer() jwt.Signer {
+	k.lk.RLock()
+	defer k.lk.RUnlock()
+	return k.current
+}
+
+// Rotate makes the given secret the signing one, while the current secret keeps verifying tokens
+// for the given grace window. Zero grace stops accepting tokens signed by the current secret
+// immediately.
+func (k *Keyring) Rotate(next jwt.Signer, grace time.Duration) {
+	k.lk.Lock()
+	defer k.lk.Unlock()
+	if grace > 0 {
+		k.previous = append(k.previous, RotatedSigner{Signer: k.current, ValidUntil: time.Now().Add(grace)})
+	}
+	k.current = next
+}
+
+// AddRotated adds secrets the Keyring was previously rotated from.
+func (k *Keyring) AddRotated(rotated ...RotatedSigner) {
+	k.lk.Lock()
+	defer k.lk.Unlock()
+	k.previous = append(k.previous, rotated...)
+}
+
+// Rotated returns the secrets the Keyring was rotated from that are still within their grace
+// window.
+func (k *Keyring) Rotated() []RotatedSigner {
+	k.lk.Lock()
+	defer k.lk.Unlock()
+	k.gc(time.Now())
+	return append([]RotatedSigner(nil), k.previous...)
+}
+
+// Revoke adds the token with the given ID and expiry to the list of revoked tokens.
+func (k *Keyring) Revoke(id string, expiresAt time.Time) {
+	k.lk.Lock()
+	defer k.lk.Unlock()
+	k.revoked[id] = expiresAt
+}
+
+// IsRevoked reports whether the token with the given ID has been revoked.
+func (k *Keyring) IsRevoked(id string) bool {
+	k.lk.RLock()
+	defer k.lk.RUnlock()
+	_, ok := k.revoked[id]
+	return ok
+}
+
+// Verify verifies the given token against the signing secret and the rotated secrets within their
+// grace window, ensuring it has neither expired nor been revoked, and returns its payload.
+func (k *Keyring) Verify(token string) (*JWTPayload, error) {
+	k.lk.Lock()
+	k.gc(time.Now())
+	signers := make([]jwt.Signer, 0, len(k.previous)+1)
+	signers = append(signers, k.current)
+	for _, prev := range k.previous {
+		signers = append(signers, prev.Signer)
+	}
+	k.lk.Unlock()
+
+	var (
+		p   *JWTPayload
+		err error
+	)
+	for _, signer := range signers {
+		p, err = VerifyToken(signer, token)
+		if !errors.Is(err, jwt.ErrInvalidSignature) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if p.ID != "" && k.IsRevoked(p.ID) {
+		return nil, fmt.Errorf("%w: token %s", ErrTokenRevoked, p.ID)
+	}
+	return p, nil
+}
+
+// gc drops the rotated secrets whose grace window has ended along with the revoked tokens that
+// have expired anyway.
+func (k *Keyring) gc(now time.Time) {
+	previous := k.previous[:0]
+	for _, prev := range k.previous {
+		if now.Before(prev.ValidUntil) {
+			previous = append(previous, prev)
+		}
+	}
+	k.previous = previous
+
+	for id, exp := range k.revoked {
+		if !exp.IsZero() && !now.Before(exp) {
+			delete(k.revoked, id)
+		}
+	}
+}
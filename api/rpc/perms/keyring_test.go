@@ -0,0 +1,55 @@
+package perms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	oldSigner, err := jwt.NewHS256([]byte("old"))
+	require.NoError(t, err)
+	newSigner, err := jwt.NewHS256([]byte("new"))
+	require.NoError(t, err)
+	expiredSigner, err := jwt.NewHS256([]byte("expired"))
+	require.NoError(t, err)
+
+	kr := NewKeyring(oldSigner)
+	kr.AddRotated(RotatedSigner{Signer: expiredSigner, ValidUntil: time.Now().Add(-time.Second)})
+
+	oldToken, err := NewTokenWithPerms(oldSigner, AllPerms)
+	require.NoError(t, err)
+	expiredToken, err := NewTokenWithPerms(expiredSigner, AllPerms)
+	require.NoError(t, err)
+
+	_, err = kr.Verify(string(oldToken))
+	require.NoError(t, err)
+	_, err = kr.Verify(string(expiredToken))
+	require.ErrorIs(t, err, jwt.ErrInvalidSignature)
+	require.Empty(t, kr.Rotated())
+
+	// tokens signed with the replaced secret are accepted within the grace window
+	kr.Rotate(newSigner, time.Hour)
+	require.Equal(t, newSigner, kr.Signer())
+	require.Len(t, kr.Rotated(), 1)
+	p, err := kr.Verify(string(oldToken))
+	require.NoError(t, err)
+
+	newToken, err := NewTokenWithPerms(kr.Signer(), ReadPerms)
+	require.NoError(t, err)
+	_, err = kr.Verify(string(newToken))
+	require.NoError(t, err)
+
+	// revoked tokens are rejected
+	kr.Revoke(p.ID, time.Time{})
+	require.True(t, kr.IsRevoked(p.ID))
+	_, err = kr.Verify(string(oldToken))
+	require.ErrorIs(t, err, ErrTokenRevoked)
+
+	// zero grace drops the replaced secret right away
+	kr.Rotate(oldSigner, 0)
+	_, err = kr.Verify(string(newToken))
+	require.ErrorIs(t, err, jwt.ErrInvalidSignature)
+}
@@ -1,7 +1,14 @@
 package perms
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc/auth"
@@ -16,21 +23,174 @@ var (
 
 var AuthKey = "Authorization"
 
+// AccountScopePrefix prefixes the scope entries restricting the keyring accounts a token may sign
+// transactions with, e.g. "account:rollup".
+const AccountScopePrefix = "account:"
+
+// ErrTokenExpired is returned when the token's expiry has passed.
+var ErrTokenExpired = errors.New("perms: token has expired")
+
 // JWTPayload is a utility struct for marshaling/unmarshalling
 // permissions into for token signing/verifying.
 type JWTPayload struct {
 	Allow []auth.Permission
+	// Scope optionally restricts the token to the listed modules (e.g. "share") or
+	// module methods (e.g. "header.GetByHeight") and to the listed keyring accounts it may sign
+	// transactions with (e.g. "account:rollup"). An empty Scope does not restrict the token.
+	Scope []string `json:",omitempty"`
+
+	// ID uniquely identifies the token.
+	ID string `json:"jti,omitempty"`
+	// IssuedAt is the time the token was signed at.
+	IssuedAt jwt.Timestamp `json:"iat,omitempty"`
+	// ExpiresAt is the time after which the token is no longer accepted.
+	// Zero value means the token never expires.
+	ExpiresAt jwt.Timestamp `json:"exp,omitempty"`
+}
+
+// NewJWTPayload constructs a new JWTPayload with a random ID for the given permissions and
+// scope, expiring after the given ttl. Zero ttl means the token never expires.
+func NewJWTPayload(perms []auth.Permission, ttl time.Duration, scope []string) (*JWTPayload, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	p := &JWTPayload{
+		Allow:    perms,
+		Scope:    scope,
+		ID:       hex.EncodeToString(id),
+		IssuedAt: jwt.Timestamp(now.Unix()),
+	}
+	if ttl > 0 {
+		p.ExpiresAt = jwt.Timestamp(now.Add(ttl).Unix())
+	}
+	return p, nil
 }
 
 func (j *JWTPayload) MarshalBinary() (data []byte, err error) {
 	return json.Marshal(j)
 }
 
+// IsExpired reports whether the token has expired at the given time.
+func (j *JWTPayload) IsExpired(now time.Time) bool {
+	if j.ExpiresAt == 0 {
+		return false
+	}
+	return !now.Before(j.ExpiresAt.Time())
+}
+
+// Expiry returns the time the token expires at or zero time if the token never expires.
+func (j *JWTPayload) Expiry() time.Time {
+	if j.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return j.ExpiresAt.Time()
+}
+
+// InScope reports whether the token is allowed to invoke the given method of the given module.
+func (j *JWTPayload) InScope(module, method string) bool {
+	restricted := false
+	for _, s := range j.Scope {
+		if strings.HasPrefix(s, AccountScopePrefix) {
+			continue
+		}
+		restricted = true
+
+		scopeModule, scopeMethod, found := strings.Cut(s, ".")
+		if scopeModule != module {
+			continue
+		}
+		if !found || scopeMethod == method {
+			return true
+		}
+	}
+	return !restricted
+}
+
+// CanSignWith reports whether the token is allowed to sign transactions with the keyring account
+// of the given name.
+func (j *JWTPayload) CanSignWith(account string) bool {
+	restricted := false
+	for _, s := range j.Scope {
+		name, found := strings.CutPrefix(s, AccountScopePrefix)
+		if !found {
+			continue
+		}
+		if name == account {
+			return true
+		}
+		restricted = true
+	}
+	return !restricted
+}
+
+type payloadKey struct{}
+
+// WithPayload attaches the payload of the token the request was made with to the context.
+func WithPayload(ctx context.Context, p *JWTPayload) context.Context {
+	return context.WithValue(ctx, payloadKey{}, p)
+}
+
+// PayloadFromContext returns the payload of the token the request was made with, if any.
+func PayloadFromContext(ctx context.Context) (*JWTPayload, bool) {
+	p, ok := ctx.Value(payloadKey{}).(*JWTPayload)
+	return p, ok
+}
+
 // NewTokenWithPerms generates and signs a new JWT token with the given secret
 // and given permissions.
 func NewTokenWithPerms(secret jwt.Signer, perms []auth.Permission) ([]byte, error) {
-	p := &JWTPayload{
-		Allow: perms,
+	return NewScopedToken(secret, perms, 0, nil)
+}
+
+// NewScopedToken generates and signs a new JWT token with the given secret and given
+// permissions, that expires after the given ttl and is restricted to the given scope.
+func NewScopedToken(secret jwt.Signer, perms []auth.Permission, ttl time.Duration, scope []string) ([]byte, error) {
+	p, err := NewJWTPayload(perms, ttl, scope)
+	if err != nil {
+		return nil, err
 	}
 	return jwt.NewTokenBuilder(secret).BuildBytes(p)
 }
+
+// UnverifiedPayload returns the payload of the given token without verifying its signature. It
+// allows the holder of a token to find out the ID and the expiry the token is revoked by.
+func UnverifiedPayload(token string) (*JWTPayload, error) {
+	tk, err := jwt.ParseString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(JWTPayload)
+	err = json.Unmarshal(tk.RawClaims(), p)
+	if err != nil {
+		return nil, err
+	}
+	if p.ID == "" {
+		return nil, errors.New("perms: token has no ID")
+	}
+	return p, nil
+}
+
+// VerifyToken verifies the signature of the given token with the given secret, ensures it has
+// not expired and returns its payload.
+func VerifyToken(secret jwt.Signer, token string) (*JWTPayload, error) {
+	tk, err := jwt.ParseAndVerifyString(token, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(JWTPayload)
+	err = json.Unmarshal(tk.RawClaims(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.IsExpired(time.Now()) {
+		return nil, fmt.Errorf("%w: token %s expired at %s", ErrTokenExpired, p.ID, p.ExpiresAt.Time())
+	}
+	return p, nil
+}
@@ -0,0 +1,25 @@
+package perms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTPayload_Scope(t *testing.T) {
+	unscoped := &JWTPayload{Allow: AllPerms}
+	require.True(t, unscoped.InScope("state", "Transfer"))
+	require.True(t, unscoped.CanSignWith("rollup"))
+
+	// account entries do not restrict the modules
+	accountsOnly := &JWTPayload{Allow: AllPerms, Scope: []string{AccountScopePrefix + "rollup"}}
+	require.True(t, accountsOnly.InScope("state", "Transfer"))
+	require.True(t, accountsOnly.CanSignWith("rollup"))
+	require.False(t, accountsOnly.CanSignWith("treasury"))
+
+	// module entries do not restrict the accounts
+	scoped := &JWTPayload{Allow: AllPerms, Scope: []string{"state.SubmitPayForBlob"}}
+	require.True(t, scoped.InScope("state", "SubmitPayForBlob"))
+	require.False(t, scoped.InScope("state", "Transfer"))
+	require.True(t, scoped.CanSignWith("treasury"))
+}
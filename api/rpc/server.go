@@ -2,14 +2,14 @@ package rpc
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
-	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/go-jsonrpc/auth"
 	logging "github.com/ipfs/go-log/v2"
@@ -26,10 +26,10 @@ type Server struct {
 
 	started atomic.Bool
 
-	auth jwt.Signer
+	auth *perms.Keyring
 }
 
-func NewServer(address, port string, secret jwt.Signer) *Server {
+func NewServer(address, port string, keyring *perms.Keyring) *Server {
 	rpc := jsonrpc.NewServer()
 	srv := &Server{
 		rpc: rpc,
@@ -38,30 +38,55 @@ func NewServer(address, port string, secret jwt.Signer) *Server {
 			// the amount of time allowed to read request headers. set to the default 2 seconds
 			ReadHeaderTimeout: 2 * time.Second,
 		},
-		auth: secret,
-	}
-	srv.srv.Handler = &auth.Handler{
-		Verify: srv.verifyAuth,
-		Next:   rpc.ServeHTTP,
+		auth: keyring,
 	}
+	srv.srv.Handler = http.HandlerFunc(srv.authHandler)
 	return srv
 }
 
-// verifyAuth is the RPC server's auth middleware. This middleware is only
-// reached if a token is provided in the header of the request, otherwise only
-// methods with `read` permissions are accessible.
-func (s *Server) verifyAuth(_ context.Context, token string) ([]auth.Permission, error) {
-	tk, err := jwt.ParseAndVerifyString(token, s.auth)
-	if err != nil {
-		return nil, err
+// authHandler extracts the token from the request and passes the request down to the RPC
+// with the token's permissions and payload attached to its context. It mirrors auth.Handler,
+// additionally keeping the payload around so that the token's scope can be enforced per method.
+func (s *Server) authHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	token := r.Header.Get(perms.AuthKey)
+	if token == "" {
+		token = r.FormValue("token")
+		if token != "" {
+			token = "Bearer " + token
+		}
 	}
-	p := new(perms.JWTPayload)
-	err = json.Unmarshal(tk.RawClaims(), p)
-	if err != nil {
-		return nil, err
+
+	if token != "" {
+		if !strings.HasPrefix(token, "Bearer ") {
+			log.Warn("missing Bearer prefix in auth header")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		token = strings.TrimPrefix(token, "Bearer ")
+
+		p, err := s.verifyAuth(ctx, token)
+		if err != nil {
+			log.Warnw("JWT verification failed", "remote", r.RemoteAddr, "err", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		ctx = auth.WithPerm(ctx, p.Allow)
+		ctx = perms.WithPayload(ctx, p)
 	}
-	// check permissions
-	return p.Allow, nil
+
+	s.rpc.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// verifyAuth is the RPC server's auth middleware. This middleware is only
+// reached if a token is provided in the header of the request, otherwise only
+// methods with `public` permissions are accessible. Tokens with invalid signatures,
+// expired or revoked tokens are rejected, while the scope of the token is enforced
+// on every method call.
+func (s *Server) verifyAuth(_ context.Context, token string) (*perms.JWTPayload, error) {
+	return s.auth.Verify(token)
 }
 
 // RegisterService registers a service onto the RPC server. All methods on the service will then be
@@ -73,10 +98,40 @@ func (s *Server) RegisterService(namespace string, service interface{}) {
 // RegisterAuthedService registers a service onto the RPC server. All methods on the service will
 // then be exposed over the RPC.
 func (s *Server) RegisterAuthedService(namespace string, service interface{}, out interface{}) {
-	auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, getInternalStruct(out))
+	internal := getInternalStruct(out)
+	auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, internal)
+	scopedProxy(namespace, internal)
 	s.RegisterService(namespace, out)
 }
 
+// scopedProxy wraps every method of the given internal struct, rejecting calls made with tokens
+// whose scope does not cover the method.
+func scopedProxy(namespace string, internal interface{}) {
+	rint := reflect.ValueOf(internal).Elem()
+	for f := 0; f < rint.NumField(); f++ {
+		field := rint.Type().Field(f)
+		next := reflect.ValueOf(rint.Field(f).Interface())
+
+		rint.Field(f).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			ctx := args[0].Interface().(context.Context)
+			p, ok := perms.PayloadFromContext(ctx)
+			if !ok || p.InScope(namespace, field.Name) {
+				return next.Call(args)
+			}
+
+			err := fmt.Errorf("token is out of scope to invoke '%s.%s'", namespace, field.Name)
+			results := make([]reflect.Value, field.Type.NumOut())
+			for i := range results {
+				results[i] = reflect.Zero(field.Type.Out(i))
+			}
+			if last := len(results) - 1; last >= 0 && field.Type.Out(last) == reflect.TypeOf(&err).Elem() {
+				results[last] = reflect.ValueOf(&err).Elem()
+			}
+			return results
+		}))
+	}
+}
+
 func getInternalStruct(api interface{}) interface{} {
 	return reflect.ValueOf(api).Elem().FieldByName("Internal").Addr().Interface()
 }
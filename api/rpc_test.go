@@ -21,6 +21,8 @@ import (
 	daspkg "github.com/celestiaorg/celestia-node/das"
 	headerpkg "github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder"
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	blobMock "github.com/celestiaorg/celestia-node/nodebuilder/blob/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
 	dasMock "github.com/celestiaorg/celestia-node/nodebuilder/das/mocks"
 	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
@@ -81,6 +83,7 @@ type api struct {
 	DAS    das.Module
 	Node   node.Module
 	P2P    p2p.Module
+	Blob   blob.Module
 }
 
 func TestModulesImplementFullAPI(t *testing.T) {
@@ -210,6 +213,67 @@ func TestAuthedRPC(t *testing.T) {
 	}
 }
 
+// TestAuthedRPCExpiryAndScope tests that expired tokens are rejected
+// and that scoped tokens can only access methods within their scope.
+func TestAuthedRPCExpiryAndScope(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// generate dummy signer and sign tokens with it
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+
+	nd, server := setupNodeWithAuthedRPC(t, signer)
+	url := nd.RPCServer.ListenAddr()
+
+	expired, err := perms.NewJWTPayload(perms.AllPerms, 0, nil)
+	require.NoError(t, err)
+	expired.ExpiresAt = jwt.Timestamp(time.Now().Add(-time.Minute).Unix())
+	expiredToken, err := jwt.NewTokenBuilder(signer).BuildBytes(expired)
+	require.NoError(t, err)
+
+	scopedToken, err := perms.NewScopedToken(signer, perms.AllPerms, time.Hour, []string{"header", "das.SamplingStats"})
+	require.NoError(t, err)
+
+	// we need to run this a few times to prevent the race where the server is not yet started
+	var rpcClient *client.Client
+	for i := 0; i < 3; i++ {
+		time.Sleep(time.Second * 1)
+		rpcClient, err = client.NewClient(ctx, "http://"+url, string(expiredToken))
+		if err == nil {
+			break
+		}
+	}
+	require.NotNil(t, rpcClient)
+	require.NoError(t, err)
+
+	// 1. Expired token is rejected even for public methods
+	_, err = rpcClient.Header.NetworkHead(ctx)
+	require.Error(t, err)
+	rpcClient.Close()
+
+	rpcClient, err = client.NewClient(ctx, "http://"+url, string(scopedToken))
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+
+	// 2. Whole module in scope
+	server.Header.EXPECT().NetworkHead(gomock.Any()).Return(new(headerpkg.ExtendedHeader), nil)
+	got, err := rpcClient.Header.NetworkHead(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	// 3. Single method in scope
+	server.Das.EXPECT().SamplingStats(gomock.Any()).Return(daspkg.SamplingStats{}, nil)
+	_, err = rpcClient.DAS.SamplingStats(ctx)
+	require.NoError(t, err)
+
+	// 4. Methods out of scope are rejected regardless of permissions
+	err = rpcClient.DAS.WaitCatchUp(ctx)
+	require.ErrorContains(t, err, "out of scope")
+	_, err = rpcClient.P2P.NATStatus(ctx)
+	require.ErrorContains(t, err, "out of scope")
+}
+
 // TestPublicClient tests that the public rpc client can only
 // access public methods.
 func TestPublicClient(t *testing.T) {
@@ -336,6 +400,7 @@ func setupNodeWithModifiedRPC(t *testing.T) (*nodebuilder.Node, *mockAPI) {
 		dasMock.NewMockModule(ctrl),
 		p2pMock.NewMockModule(ctrl),
 		nodeMock.NewMockModule(ctrl),
+		blobMock.NewMockModule(ctrl),
 	}
 
 	// given the behavior of fx.Invoke, this invoke will be called last as it is added at the root
@@ -348,6 +413,7 @@ func setupNodeWithModifiedRPC(t *testing.T) (*nodebuilder.Node, *mockAPI) {
 		srv.RegisterService("das", mockAPI.Das)
 		srv.RegisterService("p2p", mockAPI.P2P)
 		srv.RegisterService("node", mockAPI.Node)
+		srv.RegisterService("blob", mockAPI.Blob)
 	})
 	nd := nodebuilder.TestNode(t, node.Full, invokeRPC)
 	// start node
@@ -376,6 +442,7 @@ func setupNodeWithAuthedRPC(t *testing.T, auth jwt.Signer) (*nodebuilder.Node, *
 		dasMock.NewMockModule(ctrl),
 		p2pMock.NewMockModule(ctrl),
 		nodeMock.NewMockModule(ctrl),
+		blobMock.NewMockModule(ctrl),
 	}
 
 	// given the behavior of fx.Invoke, this invoke will be called last as it is added at the root
@@ -388,6 +455,7 @@ func setupNodeWithAuthedRPC(t *testing.T, auth jwt.Signer) (*nodebuilder.Node, *
 		srv.RegisterAuthedService("das", mockAPI.Das, &das.API{})
 		srv.RegisterAuthedService("p2p", mockAPI.P2P, &p2p.API{})
 		srv.RegisterAuthedService("node", mockAPI.Node, &node.API{})
+		srv.RegisterAuthedService("blob", mockAPI.Blob, &blob.API{})
 	})
 	// fx.Replace does not work here, but fx.Decorate does
 	nd := nodebuilder.TestNode(t, node.Full, invokeRPC, fx.Decorate(func() (jwt.Signer, error) {
@@ -411,4 +479,5 @@ type mockAPI struct {
 	Das    *dasMock.MockModule
 	P2P    *p2pMock.MockModule
 	Node   *nodeMock.MockModule
+	Blob   *blobMock.MockModule
 }
@@ -0,0 +1,158 @@
+package blob
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/nmt/namespace"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// Commitment is a Merkle Root of the subtree built from shares of the Blob.
+// It is computed by splitting the blob into shares and building the Merkle subtree to be included
+// after Submit.
+type Commitment []byte
+
+func (com Commitment) String() string {
+	return fmt.Sprintf("%X", []byte(com))
+}
+
+// Equal ensures that commitments are the same
+func (com Commitment) Equal(c Commitment) bool {
+	return bytes.Equal(com, c)
+}
+
+// Proof is a collection of nmt.Proofs that verifies the inclusion of the data.
+// Each proof covers the shares of the Blob's namespace within a single row of the EDS.
+type Proof []*nmt.Proof
+
+// Len returns the amount of rows the Proof covers.
+func (p Proof) Len() int { return len(p) }
+
+// verify checks that the Proof proves the inclusion of the shares of the given rows under the
+// given namespace, each against the respective row root.
+func (p Proof) verify(rows share.NamespacedShares, rowRoots [][]byte, nID namespace.ID) bool {
+	if p.Len() != len(rows) || p.Len() != len(rowRoots) {
+		return false
+	}
+
+	for i, proof := range p {
+		row := share.NamespacedRow{Shares: rows[i].Shares, Proof: proof}
+		if !row.Verify(rowRoots[i], nID) {
+			return false
+		}
+	}
+	return true
+}
+
+type jsonProof struct {
+	Start                   int      `json:"start"`
+	End                     int      `json:"end"`
+	Nodes                   [][]byte `json:"nodes"`
+	LeafHash                []byte   `json:"leaf_hash,omitempty"`
+	IsMaxNamespaceIDIgnored bool     `json:"is_max_namespace_id_ignored"`
+}
+
+// MarshalJSON encodes the Proof, exposing the otherwise unexported fields of every nmt.Proof.
+func (p Proof) MarshalJSON() ([]byte, error) {
+	proofs := make([]jsonProof, len(p))
+	for i, proof := range p {
+		proofs[i] = jsonProof{
+			Start:                   proof.Start(),
+			End:                     proof.End(),
+			Nodes:                   proof.Nodes(),
+			LeafHash:                proof.LeafHash(),
+			IsMaxNamespaceIDIgnored: proof.IsMaxNamespaceIDIgnored(),
+		}
+	}
+	return json.Marshal(proofs)
+}
+
+// UnmarshalJSON decodes the Proof encoded with MarshalJSON.
+func (p *Proof) UnmarshalJSON(data []byte) error {
+	var proofs []jsonProof
+	err := json.Unmarshal(data, &proofs)
+	if err != nil {
+		return err
+	}
+
+	*p = make(Proof, len(proofs))
+	for i, proof := range proofs {
+		var nmtProof nmt.Proof
+		if len(proof.LeafHash) > 0 {
+			nmtProof = nmt.NewAbsenceProof(
+				proof.Start, proof.End, proof.Nodes, proof.LeafHash, proof.IsMaxNamespaceIDIgnored,
+			)
+		} else {
+			nmtProof = nmt.NewInclusionProof(proof.Start, proof.End, proof.Nodes, proof.IsMaxNamespaceIDIgnored)
+		}
+		(*p)[i] = &nmtProof
+	}
+	return nil
+}
+
+// Blob represents any application-specific binary data that anyone can submit to Celestia.
+type Blob struct {
+	types.Blob `json:"blob"`
+
+	Commitment Commitment `json:"commitment"`
+}
+
+// NewBlob constructs a new blob from the provided namespace.ID and data.
+func NewBlob(shareVersion uint8, nID namespace.ID, data []byte) (*Blob, error) {
+	if len(nID) != appconsts.NamespaceSize {
+		return nil, fmt.Errorf("invalid size of the namespace id. got:%d, want:%d", len(nID), appconsts.NamespaceSize)
+	}
+
+	if err := types.ValidateBlobNamespaceID(nID); err != nil {
+		return nil, err
+	}
+
+	blob := types.Blob{NamespaceId: nID, Data: data, ShareVersion: uint32(shareVersion)}
+	com, err := types.CreateCommitment(&blob)
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{Blob: blob, Commitment: com}, nil
+}
+
+// Namespace returns blob's namespace.
+func (b *Blob) Namespace() namespace.ID {
+	return b.NamespaceId
+}
+
+type jsonBlob struct {
+	Namespace    namespace.ID `json:"namespace"`
+	Data         []byte       `json:"data"`
+	ShareVersion uint32       `json:"share_version"`
+	Commitment   Commitment   `json:"commitment"`
+}
+
+func (b *Blob) MarshalJSON() ([]byte, error) {
+	blob := &jsonBlob{
+		Namespace:    b.Namespace(),
+		Data:         b.Data,
+		ShareVersion: b.ShareVersion,
+		Commitment:   b.Commitment,
+	}
+	return json.Marshal(blob)
+}
+
+func (b *Blob) UnmarshalJSON(data []byte) error {
+	var blob jsonBlob
+	err := json.Unmarshal(data, &blob)
+	if err != nil {
+		return err
+	}
+
+	b.Blob.NamespaceId = blob.Namespace
+	b.Blob.Data = blob.Data
+	b.Blob.ShareVersion = blob.ShareVersion
+	b.Commitment = blob.Commitment
+	return nil
+}
@@ -0,0 +1,9 @@
+// Package blob provides access to the application-specific data (blobs) posted to the
+// Celestia network.
+//
+// A Blob is identified by the height it was included at, its namespace and its
+// Commitment (the Merkle subtree root of the blob's shares). The Service submits blobs
+// within a single PayForBlob transaction and retrieves them back by parsing the shares of
+// the requested namespace out of the block's extended data square, accompanying every
+// retrieved blob with the NMT proofs of the rows it spans.
+package blob
@@ -0,0 +1,94 @@
+package blob
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// rowSpan is the range of rows, inclusive on both ends, a Blob spans within the NamespacedShares
+// it was parsed from.
+type rowSpan struct {
+	first, last int
+}
+
+// proof collects the Proof of the rows within the span.
+func (rs rowSpan) proof(rows share.NamespacedShares) Proof {
+	proof := make(Proof, 0, rs.last-rs.first+1)
+	for _, row := range rows[rs.first : rs.last+1] {
+		proof = append(proof, row.Proof)
+	}
+	return proof
+}
+
+// blobsFromRows parses all blobs out of the given rows of a single namespace. Each parsed Blob is
+// accompanied by the span of the rows it covers.
+func blobsFromRows(rows share.NamespacedShares) ([]*Blob, []rowSpan, error) {
+	var (
+		rawShares = make([]share.Share, 0)
+		// rowIdx maps the position of a share in rawShares to the position of its row in rows.
+		rowIdx = make([]int, 0)
+	)
+	for i, row := range rows {
+		for _, sh := range row.Shares {
+			rawShares = append(rawShares, sh)
+			rowIdx = append(rowIdx, i)
+		}
+	}
+
+	blobs := make([]*Blob, 0)
+	spans := make([]rowSpan, 0)
+	for start := 0; start < len(rawShares); {
+		sh, err := shares.NewShare(rawShares[start])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		isPadding, err := sh.IsPadding()
+		if err != nil {
+			return nil, nil, err
+		}
+		if isPadding {
+			start++
+			continue
+		}
+
+		isStart, err := sh.IsSequenceStart()
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isStart {
+			return nil, nil, fmt.Errorf("share at index %d is not a start of a blob", start)
+		}
+
+		seqLen, err := sh.SequenceLen()
+		if err != nil {
+			return nil, nil, err
+		}
+		end := start + shares.SparseSharesNeeded(seqLen)
+		if end > len(rawShares) {
+			return nil, nil, fmt.Errorf("blob at index %d is incomplete: expected %d shares, got %d",
+				start, end-start, len(rawShares)-start)
+		}
+
+		parsed, err := shares.ParseBlobs(rawShares[start:end])
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(parsed) != 1 {
+			return nil, nil, fmt.Errorf("expected to parse a single blob at index %d, got %d", start, len(parsed))
+		}
+
+		blob, err := NewBlob(parsed[0].ShareVersion, parsed[0].NamespaceID, parsed[0].Data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		blobs = append(blobs, blob)
+		spans = append(spans, rowSpan{first: rowIdx[start], last: rowIdx[end-1]})
+		start = end
+	}
+	return blobs, spans, nil
+}
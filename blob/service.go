@@ -0,0 +1,236 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	logging "github.com/ipfs/go-log/v2"
+
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/celestiaorg/nmt/namespace"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob: not found")
+	ErrInvalidProof = errors.New("blob: invalid proof")
+
+	log = logging.Logger("blob")
+)
+
+// Submitter is an interface that allows submitting blobs to the celestia-core. It is used to
+// avoid a circular dependency between the blob and the state package. Zero fee and gas limit are
+// estimated by the Submitter. The transaction is signed with the Submitter's default account, which
+// pays the fee.
+type Submitter interface {
+	SubmitPayForBlobs(
+		ctx context.Context,
+		fee state.Int,
+		gasLim uint64,
+		blobs []*apptypes.Blob,
+	) (*state.TxResponse, error)
+}
+
+// Service provides access to the blobs posted to the network: it submits them through the
+// Submitter and retrieves them back from the EDS of the requested height.
+type Service struct {
+	// blobSubmitter submits PayForBlob transactions to celestia-core.
+	blobSubmitter Submitter
+	// shareGetter retrieves the shares of the requested namespace from the EDS.
+	shareGetter share.Getter
+	// headerGetter fetches the header by the provided height.
+	headerGetter func(context.Context, uint64) (*header.ExtendedHeader, error)
+}
+
+func NewService(
+	submitter Submitter,
+	getter share.Getter,
+	headerGetter func(context.Context, uint64) (*header.ExtendedHeader, error),
+) *Service {
+	return &Service{
+		blobSubmitter: submitter,
+		shareGetter:   getter,
+		headerGetter:  headerGetter,
+	}
+}
+
+// Submit sends a PayForBlob transaction for the given blobs and blocks until it is included.
+// It returns the height of the block that included the blobs.
+//
+// NOTE: the transaction is always signed with the node's default account, which pays the fee. The
+// state module's SubmitPayForBlobsWithOptions selects another keyring account to sign with or the
+// fee granter.
+func (s *Service) Submit(ctx context.Context, blobs []*Blob) (uint64, error) {
+	if len(blobs) == 0 {
+		return 0, errors.New("blob: nothing to submit")
+	}
+	log.Debugw("submitting blobs", "amount", len(blobs))
+
+	b := make([]*apptypes.Blob, len(blobs))
+	for i, blob := range blobs {
+		b[i] = &blob.Blob
+	}
+
+	// the gas and the fee are estimated by the submitter
+	resp, err := s.blobSubmitter.SubmitPayForBlobs(ctx, math.ZeroInt(), 0, b)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Code != 0 {
+		return 0, fmt.Errorf("blob: transaction %s failed with code %d: %s", resp.TxHash, resp.Code, resp.RawLog)
+	}
+	return uint64(resp.Height), nil
+}
+
+// Get retrieves the blob by its commitment under the given namespace and height.
+func (s *Service) Get(ctx context.Context, height uint64, nID namespace.ID, commitment Commitment) (*Blob, error) {
+	blob, _, err := s.getByCommitment(ctx, height, nID, commitment)
+	if err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// GetProof retrieves the Proof of the blob identified by its commitment under the given
+// namespace and height.
+func (s *Service) GetProof(
+	ctx context.Context,
+	height uint64,
+	nID namespace.ID,
+	commitment Commitment,
+) (*Proof, error) {
+	_, proof, err := s.getByCommitment(ctx, height, nID, commitment)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// GetAll returns all the blobs found under the given namespaces at the given height.
+// It returns ErrBlobNotFound only if none of the namespaces contain any blobs.
+func (s *Service) GetAll(ctx context.Context, height uint64, nIDs []namespace.ID) ([]*Blob, error) {
+	header, err := s.headerGetter(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]*Blob, 0)
+	for _, nID := range nIDs {
+		log.Debugw("performing GetAll request", "nID", nID.String(), "height", height)
+		nsBlobs, _, _, err := s.getBlobs(ctx, nID, header.DAH)
+		if errors.Is(err, ErrBlobNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, nsBlobs...)
+	}
+
+	if len(blobs) == 0 {
+		return nil, ErrBlobNotFound
+	}
+	return blobs, nil
+}
+
+// Included verifies that the blob with the given commitment was included under the given
+// namespace at the given height by checking the given proof against the row roots of the height's
+// header. It reports false if the blob is not found or the proof does not prove its inclusion.
+func (s *Service) Included(
+	ctx context.Context,
+	height uint64,
+	nID namespace.ID,
+	proof *Proof,
+	commitment Commitment,
+) (bool, error) {
+	if proof == nil {
+		return false, ErrInvalidProof
+	}
+
+	header, err := s.headerGetter(ctx, height)
+	if err != nil {
+		return false, err
+	}
+
+	blobs, spans, rows, err := s.getBlobs(ctx, nID, header.DAH)
+	if errors.Is(err, ErrBlobNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// the commitment is derived from the blob's data, so proving the shares of the rows the blob
+	// spans proves the commitment
+	rowRoots := share.RowRootsByNamespace(header.DAH, nID)
+	if len(rowRoots) != len(rows) {
+		return false, fmt.Errorf("blob: amount of rows differs between root and namespace shares: "+
+			"expected %d, got %d", len(rowRoots), len(rows))
+	}
+	for i, blob := range blobs {
+		if !blob.Commitment.Equal(commitment) {
+			continue
+		}
+		span := spans[i]
+		return proof.verify(rows[span.first:span.last+1], rowRoots[span.first:span.last+1], nID), nil
+	}
+	return false, nil
+}
+
+// getByCommitment retrieves the blob and its Proof by the given commitment.
+func (s *Service) getByCommitment(
+	ctx context.Context,
+	height uint64,
+	nID namespace.ID,
+	commitment Commitment,
+) (*Blob, *Proof, error) {
+	log.Debugw("retrieving blob by commitment", "height", height, "nID", nID.String())
+
+	header, err := s.headerGetter(ctx, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobs, spans, rows, err := s.getBlobs(ctx, nID, header.DAH)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, blob := range blobs {
+		if blob.Commitment.Equal(commitment) {
+			proof := spans[i].proof(rows)
+			return blob, &proof, nil
+		}
+	}
+	return nil, nil, ErrBlobNotFound
+}
+
+// getBlobs retrieves and parses all the blobs under the given namespace from the EDS of the
+// given root. Along with the blobs, it returns the spans of the returned rows each blob covers.
+func (s *Service) getBlobs(
+	ctx context.Context,
+	nID namespace.ID,
+	root *share.Root,
+) ([]*Blob, []rowSpan, share.NamespacedShares, error) {
+	rows, err := s.shareGetter.GetSharesByNamespace(ctx, root, nID)
+	if errors.Is(err, share.ErrNotFound) {
+		return nil, nil, nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	blobs, spans, err := blobsFromRows(rows)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("blob: parsing shares under namespace %s: %w", nID.String(), err)
+	}
+	if len(blobs) == 0 {
+		return nil, nil, nil, ErrBlobNotFound
+	}
+	return blobs, spans, rows, nil
+}
@@ -0,0 +1,216 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appshares "github.com/celestiaorg/celestia-app/pkg/shares"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/nmt/namespace"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/getters"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+func TestBlobService_Get(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	nID1 := namespace.ID{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	nID2 := namespace.ID{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	nIDAbsent := namespace.ID{0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03}
+
+	blobs := []*Blob{
+		newTestBlob(t, nID1, 16),
+		// spans multiple shares
+		newTestBlob(t, nID1, appconsts.ShareSize*3),
+		newTestBlob(t, nID2, appconsts.ShareSize*2),
+	}
+	service := createService(ctx, t, blobs)
+
+	t.Run("Get", func(t *testing.T) {
+		for _, expected := range blobs {
+			blob, err := service.Get(ctx, 1, expected.Namespace(), expected.Commitment)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Commitment, blob.Commitment)
+			assert.Equal(t, expected.Data, blob.Data)
+			assert.True(t, bytes.Equal(expected.Namespace(), blob.Namespace()))
+		}
+	})
+
+	t.Run("GetUnknownCommitment", func(t *testing.T) {
+		_, err := service.Get(ctx, 1, nID1, blobs[2].Commitment)
+		require.ErrorIs(t, err, ErrBlobNotFound)
+	})
+
+	t.Run("GetAbsentNamespace", func(t *testing.T) {
+		_, err := service.Get(ctx, 1, nIDAbsent, blobs[0].Commitment)
+		require.ErrorIs(t, err, ErrBlobNotFound)
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		all, err := service.GetAll(ctx, 1, []namespace.ID{nID1, nIDAbsent, nID2})
+		require.NoError(t, err)
+		require.Len(t, all, len(blobs))
+		for i, expected := range blobs {
+			assert.Equal(t, expected.Commitment, all[i].Commitment)
+		}
+
+		_, err = service.GetAll(ctx, 1, []namespace.ID{nIDAbsent})
+		require.ErrorIs(t, err, ErrBlobNotFound)
+	})
+
+	t.Run("Included", func(t *testing.T) {
+		proof, err := service.GetProof(ctx, 1, nID1, blobs[1].Commitment)
+		require.NoError(t, err)
+		require.NotZero(t, proof.Len())
+
+		included, err := service.Included(ctx, 1, nID1, proof, blobs[1].Commitment)
+		require.NoError(t, err)
+		require.True(t, included)
+
+		otherProof, err := service.GetProof(ctx, 1, nID2, blobs[2].Commitment)
+		require.NoError(t, err)
+		included, err = service.Included(ctx, 1, nID1, otherProof, blobs[1].Commitment)
+		require.NoError(t, err)
+		require.False(t, included)
+
+		// a proof of the blob's rows with a single node of the proof altered
+		nodes := (*proof)[0].Nodes()
+		require.NotEmpty(t, nodes)
+		tampered := make([][]byte, len(nodes))
+		copy(tampered, nodes)
+		tampered[0] = bytes.Repeat([]byte{0xFF}, len(nodes[0]))
+		tamperedProof := append(Proof{}, *proof...)
+		altered := nmt.NewInclusionProof((*proof)[0].Start(), (*proof)[0].End(), tampered, false)
+		tamperedProof[0] = &altered
+		included, err = service.Included(ctx, 1, nID1, &tamperedProof, blobs[1].Commitment)
+		require.NoError(t, err)
+		require.False(t, included)
+
+		_, err = service.Included(ctx, 1, nID1, nil, blobs[1].Commitment)
+		require.ErrorIs(t, err, ErrInvalidProof)
+
+		included, err = service.Included(ctx, 1, nID1, proof, blobs[2].Commitment)
+		require.NoError(t, err)
+		require.False(t, included)
+	})
+}
+
+func TestBlobService_Submit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	nID := namespace.ID{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	blobs := []*Blob{newTestBlob(t, nID, 16), newTestBlob(t, nID, appconsts.ShareSize*2)}
+
+	submitter := &testSubmitter{height: 42}
+	service := NewService(submitter, nil, nil)
+
+	height, err := service.Submit(ctx, blobs)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+	require.Len(t, submitter.submitted, len(blobs))
+	// the gas is left for the submitter to estimate
+	require.Zero(t, submitter.gasLim)
+
+	_, err = service.Submit(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestProof_JSON(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	nID := namespace.ID{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	blobs := []*Blob{newTestBlob(t, nID, appconsts.ShareSize*3)}
+	service := createService(ctx, t, blobs)
+
+	proof, err := service.GetProof(ctx, 1, nID, blobs[0].Commitment)
+	require.NoError(t, err)
+
+	data, err := proof.MarshalJSON()
+	require.NoError(t, err)
+	var decoded Proof
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	included, err := service.Included(ctx, 1, nID, &decoded, blobs[0].Commitment)
+	require.NoError(t, err)
+	require.True(t, included)
+
+	data, err = blobs[0].MarshalJSON()
+	require.NoError(t, err)
+	var decodedBlob Blob
+	require.NoError(t, decodedBlob.UnmarshalJSON(data))
+	require.Equal(t, blobs[0], &decodedBlob)
+}
+
+// createService builds a square out of the given blobs, stores it in the blockservice and
+// returns the Service that serves it at any height.
+func createService(ctx context.Context, t *testing.T, blobs []*Blob) *Service {
+	coreBlobs := make([]coretypes.Blob, len(blobs))
+	for i, b := range blobs {
+		coreBlobs[i] = coretypes.Blob{
+			NamespaceID:  b.NamespaceId,
+			Data:         b.Data,
+			ShareVersion: uint8(b.ShareVersion),
+		}
+	}
+	sort.SliceStable(coreBlobs, func(i, j int) bool {
+		return bytes.Compare(coreBlobs[i].NamespaceID, coreBlobs[j].NamespaceID) < 0
+	})
+
+	blobShares, err := appshares.SplitBlobs(0, nil, coreBlobs, false)
+	require.NoError(t, err)
+	rawShares := appshares.ToBytes(blobShares)
+
+	squareSize := appshares.RoundUpPowerOfTwo(appshares.MinSquareSize(len(rawShares)))
+	padding := appshares.TailPaddingShares(squareSize*squareSize - len(rawShares))
+	rawShares = append(rawShares, appshares.ToBytes(padding)...)
+
+	bServ := mdutils.Bserv()
+	eds, err := share.AddShares(ctx, rawShares, bServ)
+	require.NoError(t, err)
+	dah := da.NewDataAvailabilityHeader(eds)
+	hdr := &header.ExtendedHeader{DAH: &dah}
+
+	return NewService(nil, getters.NewIPLDGetter(bServ), func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return hdr, nil
+	})
+}
+
+func newTestBlob(t *testing.T, nID namespace.ID, size int) *Blob {
+	blob, err := NewBlob(appconsts.ShareVersionZero, nID, tmrand.Bytes(size))
+	require.NoError(t, err)
+	return blob
+}
+
+type testSubmitter struct {
+	height    int64
+	gasLim    uint64
+	submitted []*apptypes.Blob
+}
+
+func (ts *testSubmitter) SubmitPayForBlobs(
+	_ context.Context,
+	_ state.Int,
+	gasLim uint64,
+	blobs []*apptypes.Blob,
+) (*state.TxResponse, error) {
+	ts.gasLim = gasLim
+	ts.submitted = blobs
+	return &state.TxResponse{Height: ts.height}, nil
+}
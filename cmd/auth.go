@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"time"
 
 	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc/auth"
@@ -18,6 +19,12 @@ import (
 	nodemod "github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
+const (
+	authTTLFlag   = "ttl"
+	authScopeFlag = "scope"
+	authGraceFlag = "grace"
+)
+
 func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "auth [permission-level (e.g. read || write || admin)]",
@@ -30,6 +37,49 @@ func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 	for _, set := range fsets {
 		cmd.Flags().AddFlagSet(set)
 	}
+	cmd.AddCommand(authRotateCmd(fsets...))
+	cmd.Flags().Duration(authTTLFlag, 0, "Time after which the token expires (default: never)")
+	cmd.Flags().StringSlice(
+		authScopeFlag,
+		nil,
+		"Modules (e.g. share) or module methods (e.g. header.GetByHeight) the token is restricted to "+
+			"(default: unrestricted)",
+	)
+	return cmd
+}
+
+func authRotateCmd(fsets ...*flag.FlagSet) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "rotate",
+		Args:  cobra.NoArgs,
+		Short: "Rotates the secret JWT tokens are signed with.",
+		Long: "Replaces the secret JWT tokens are signed with by a newly generated one. Tokens signed with the " +
+			"replaced secret keep being accepted for the grace window. NOTE: only use this command when the node " +
+			"is stopped, as the new secret is only picked up on start. Use the node.AuthRotate RPC method to rotate " +
+			"the secret of the running node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grace, err := cmd.Flags().GetDuration(authGraceFlag)
+			if err != nil {
+				return err
+			}
+
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = nodemod.RotateSecret(ks, grace)
+			return err
+		},
+	}
+
+	for _, set := range fsets {
+		cmd.Flags().AddFlagSet(set)
+	}
+	cmd.Flags().Duration(
+		authGraceFlag,
+		time.Hour,
+		"Time during which the tokens signed with the replaced secret are still accepted",
+	)
 	return cmd
 }
 
@@ -43,11 +93,16 @@ func newToken(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	expanded, err := homedir.Expand(filepath.Clean(StorePath(cmd.Context())))
+	ttl, err := cmd.Flags().GetDuration(authTTLFlag)
+	if err != nil {
+		return err
+	}
+	scope, err := cmd.Flags().GetStringSlice(authScopeFlag)
 	if err != nil {
 		return err
 	}
-	ks, err := keystore.NewFSKeystore(filepath.Join(expanded, "keys"), nil)
+
+	ks, err := openKeystore(cmd)
 	if err != nil {
 		return err
 	}
@@ -70,17 +125,23 @@ func newToken(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	token, err := jwt.NewTokenBuilder(signer).Build(&perms.JWTPayload{
-		Allow: permissions,
-	})
+	token, err := perms.NewScopedToken(signer, permissions, ttl, scope)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s", token.InsecureString())
+	fmt.Printf("%s", token)
 	return nil
 }
 
+func openKeystore(cmd *cobra.Command) (keystore.Keystore, error) {
+	expanded, err := homedir.Expand(filepath.Clean(StorePath(cmd.Context())))
+	if err != nil {
+		return nil, err
+	}
+	return keystore.NewFSKeystore(filepath.Join(expanded, "keys"), nil)
+}
+
 func generateNewKey(ks keystore.Keystore) (keystore.PrivKey, error) {
 	sk, err := io.ReadAll(io.LimitReader(rand.Reader, 32))
 	if err != nil {
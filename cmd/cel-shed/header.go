@@ -1,21 +1,25 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/ipfs/go-datastore"
 	"github.com/spf13/cobra"
 
 	"github.com/celestiaorg/go-header/store"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/pruner"
 	"github.com/celestiaorg/celestia-node/nodebuilder"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 )
 
 func init() {
-	headerCmd.AddCommand(headerStoreInit)
+	headerCmd.AddCommand(headerStoreInit, headerStorePrune)
 }
 
 var headerCmd = &cobra.Command{
@@ -69,3 +73,75 @@ Custom store path is not supported yet.`,
 		return hstore.Init(cmd.Context(), newHead)
 	},
 }
+
+var headerStorePrune = &cobra.Command{
+	Use: "prune [node-type] [network] [keep-heights]",
+	Short: `Remove all the headers except the given amount of the most recent ones from the header store 
+along with their cached sampling results and compact the datastore. Only supported by light nodes. 
+Requires the node being stopped. Custom store path is not supported yet.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 3 {
+			return fmt.Errorf("not enough arguments")
+		}
+
+		tp := node.ParseType(args[0])
+		if !tp.IsValid() {
+			return fmt.Errorf("invalid node-type")
+		}
+		// full and bridge nodes serve the headers to the network, so they keep all of them
+		if tp != node.Light {
+			return fmt.Errorf("header pruning is only supported by light nodes")
+		}
+
+		network := args[1]
+
+		keep, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil || keep == 0 {
+			return fmt.Errorf("invalid keep-heights: %s", args[2])
+		}
+
+		s, err := nodebuilder.OpenStore(fmt.Sprintf("~/.celestia-%s-%s", strings.ToLower(tp.String()),
+			strings.ToLower(network)), nil)
+		if err != nil {
+			return err
+		}
+		defer s.Close()
+
+		ds, err := s.Datastore()
+		if err != nil {
+			return err
+		}
+
+		hstore, err := store.NewStore[*header.ExtendedHeader](ds)
+		if err != nil {
+			return err
+		}
+		// loads the head of the store
+		if _, err = hstore.Head(cmd.Context()); err != nil {
+			return err
+		}
+
+		ca := cache.NewShareAvailability(nil, ds)
+		params := pruner.DefaultParameters()
+		params.KeepHeights = keep
+		p, err := pruner.NewPruner(params, hstore, ds, func(ctx context.Context, h *header.ExtendedHeader) error {
+			return ca.Prune(ctx, uint64(h.Height()), h.DAH)
+		})
+		if err != nil {
+			return err
+		}
+		if err = p.Prune(cmd.Context()); err != nil {
+			return err
+		}
+		if err = ca.Close(cmd.Context()); err != nil {
+			return err
+		}
+		fmt.Printf("pruned headers up to height %d\n", p.LastPruned())
+
+		if gcds, ok := ds.(datastore.GCDatastore); ok {
+			return gcds.CollectGarbage(cmd.Context())
+		}
+		return nil
+	},
+}
@@ -14,11 +14,17 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/nmt/namespace"
+
 	"github.com/celestiaorg/celestia-node/api/rpc/client"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/blob"
 	"github.com/celestiaorg/celestia-node/state"
 )
 
@@ -98,29 +104,19 @@ func parseParams(method string, params []string) []interface{} {
 		}
 		parsedParams[0] = root
 		// 2. NamespaceID
-		if strings.HasPrefix(params[1], "0x") {
-			decoded, err := hex.DecodeString(params[1][2:])
-			if err != nil {
-				panic("Error decoding namespace ID: hex string could not be decoded.")
-			}
-			parsedParams[1] = decoded
-		} else {
-			// otherwise, it's just a base64 string
-			parsedParams[1] = params[1]
+		nID, err := parseNamespaceID(params[1])
+		if err != nil {
+			panic(err)
 		}
+		parsedParams[1] = nID
 		return parsedParams
-	case "SubmitPayForBlob":
+	case "SubmitPayForBlob", "SubmitPayForBlobWithOptions":
 		// 1. NamespaceID
-		if strings.HasPrefix(params[0], "0x") {
-			decoded, err := hex.DecodeString(params[0][2:])
-			if err != nil {
-				panic("Error decoding namespace ID: hex string could not be decoded.")
-			}
-			parsedParams[0] = decoded
-		} else {
-			// otherwise, it's just a base64 string
-			parsedParams[0] = params[0]
+		nID, err := parseNamespaceID(params[0])
+		if err != nil {
+			panic(err)
 		}
+		parsedParams[0] = nID
 		// 2. Blob
 		switch {
 		case strings.HasPrefix(params[1], "0x"):
@@ -144,8 +140,87 @@ func parseParams(method string, params []string) []interface{} {
 			panic("Error parsing gas limit: uint64 could not be parsed.")
 		}
 		parsedParams[3] = num
+		// 5. Options (optional)
+		return withTxOptions(method, parsedParams, params, 4)
+	case "Submit":
+		// 1. NamespaceID
+		nID, err := parseNamespaceID(params[0])
+		if err != nil {
+			panic(err)
+		}
+		// 2. Blob data
+		data, err := decodeData(params[1])
+		if err != nil {
+			panic(err)
+		}
+		parsedBlob, err := blob.NewBlob(appconsts.ShareVersionZero, nID, data)
+		if err != nil {
+			panic(fmt.Errorf("error creating blob: %w", err))
+		}
+		return []interface{}{[]*blob.Blob{parsedBlob}}
+	case "Get", "GetProof":
+		// 1. Height
+		num, err := strconv.ParseUint(params[0], 10, 64)
+		if err != nil {
+			panic("Error parsing height: uint64 could not be parsed.")
+		}
+		parsedParams[0] = num
+		// 2. NamespaceID
+		nID, err := parseNamespaceID(params[1])
+		if err != nil {
+			panic(err)
+		}
+		parsedParams[1] = nID
+		// 3. Commitment
+		commitment, err := decodeData(params[2])
+		if err != nil {
+			panic(fmt.Errorf("error decoding commitment: %w", err))
+		}
+		parsedParams[2] = commitment
+		return parsedParams
+	case "GetAll":
+		// 1. Height
+		num, err := strconv.ParseUint(params[0], 10, 64)
+		if err != nil {
+			panic("Error parsing height: uint64 could not be parsed.")
+		}
+		// 2. NamespaceIDs
+		nIDs := make([]namespace.ID, len(params)-1)
+		for i, param := range params[1:] {
+			nIDs[i], err = parseNamespaceID(param)
+			if err != nil {
+				panic(err)
+			}
+		}
+		return []interface{}{num, nIDs}
+	case "AuthRotate":
+		// 1. Grace window
+		grace, err := time.ParseDuration(params[0])
+		if err != nil {
+			panic(fmt.Errorf("error parsing grace window: %w", err))
+		}
+		parsedParams[0] = grace
 		return parsedParams
-	case "QueryDelegation", "QueryUnbonding", "BalanceForAddress":
+	case "AuthRevoke":
+		// 1. Token ID, which is extracted locally along with the expiry if the token itself is given
+		// 2. Token expiry in RFC3339 (optional), the revocation is kept for good without it
+		if strings.Count(params[0], ".") == 2 {
+			p, err := perms.UnverifiedPayload(params[0])
+			if err != nil {
+				panic(fmt.Errorf("error reading token ID: %w", err))
+			}
+			return []interface{}{p.ID, p.Expiry()}
+		}
+		var expiresAt time.Time
+		if len(params) > 1 {
+			var err error
+			expiresAt, err = time.Parse(time.RFC3339, params[1])
+			if err != nil {
+				panic(fmt.Errorf("error parsing token expiry: %w", err))
+			}
+		}
+		return []interface{}{params[0], expiresAt}
+	case "QueryDelegation", "QueryUnbonding", "QueryValidator", "QueryAccount", "BalanceForAddress":
 		var err error
 		parsedParams[0], err = parseAddressFromString(params[0])
 		if err != nil {
@@ -163,7 +238,8 @@ func parseParams(method string, params []string) []interface{} {
 			panic(fmt.Errorf("error parsing address: %w", err))
 		}
 		return parsedParams
-	case "Transfer", "Delegate", "Undelegate":
+	case "Transfer", "Delegate", "Undelegate",
+		"TransferWithOptions", "DelegateWithOptions", "UndelegateWithOptions":
 		// 1. Address
 		var err error
 		parsedParams[0], err = parseAddressFromString(params[0])
@@ -179,8 +255,44 @@ func parseParams(method string, params []string) []interface{} {
 			panic("Error parsing gas limit: uint64 could not be parsed.")
 		}
 		parsedParams[3] = num
-		return parsedParams
-	case "CancelUnbondingDelegation":
+		// 4. Options (optional)
+		return withTxOptions(method, parsedParams, params, 4)
+	case "GrantFee":
+		// 1. Grantee
+		var err error
+		parsedParams[0], err = parseAddressFromString(params[0])
+		if err != nil {
+			panic(fmt.Errorf("error parsing address: %w", err))
+		}
+		// 2. SpendLimit + Fee
+		parsedParams[1] = params[1]
+		parsedParams[2] = params[2]
+		// 3. GasLimit (uint64)
+		num, err := strconv.ParseUint(params[3], 10, 64)
+		if err != nil {
+			panic("Error parsing gas limit: uint64 could not be parsed.")
+		}
+		parsedParams[3] = num
+		// 4. Options (optional)
+		return withTxOptions(method, parsedParams, params, 4)
+	case "RevokeFee", "GrantPayForBlob", "RevokePayForBlob":
+		// 1. Grantee
+		var err error
+		parsedParams[0], err = parseAddressFromString(params[0])
+		if err != nil {
+			panic(fmt.Errorf("error parsing address: %w", err))
+		}
+		// 2. Fee
+		parsedParams[1] = params[1]
+		// 3. GasLimit (uint64)
+		num, err := strconv.ParseUint(params[2], 10, 64)
+		if err != nil {
+			panic("Error parsing gas limit: uint64 could not be parsed.")
+		}
+		parsedParams[2] = num
+		// 4. Options (optional)
+		return withTxOptions(method, parsedParams, params, 3)
+	case "CancelUnbondingDelegation", "CancelUnbondingDelegationWithOptions":
 		// 1. Validator Address
 		var err error
 		parsedParams[0], err = parseAddressFromString(params[0])
@@ -197,7 +309,9 @@ func parseParams(method string, params []string) []interface{} {
 			panic("Error parsing gas limit: uint64 could not be parsed.")
 		}
 		parsedParams[4] = num
-	case "BeginRedelegate":
+		// 5. Options (optional)
+		return withTxOptions(method, parsedParams, params, 5)
+	case "BeginRedelegate", "BeginRedelegateWithOptions":
 		// 1. Source Validator Address
 		var err error
 		parsedParams[0], err = parseAddressFromString(params[0])
@@ -218,6 +332,8 @@ func parseParams(method string, params []string) []interface{} {
 			panic("Error parsing gas limit: uint64 could not be parsed.")
 		}
 		parsedParams[4] = num
+		// 5. Options (optional)
+		return withTxOptions(method, parsedParams, params, 5)
 	default:
 	}
 
@@ -287,6 +403,25 @@ func sendJSONRPCRequest(namespace, method string, params []interface{}) {
 	fmt.Println(string(responseBody))
 }
 
+// withTxOptions sets the optional transaction options of the *WithOptions and the grant methods,
+// given as JSON, e.g. {"account":"rollup","fee_granter":"celestia1..."}, that follow the given
+// amount of params. The node uses its defaults if the options are omitted. The other methods take
+// no options.
+func withTxOptions(method string, parsedParams []interface{}, params []string, n int) []interface{} {
+	if !strings.HasSuffix(method, "WithOptions") && !strings.HasPrefix(method, "Grant") &&
+		!strings.HasPrefix(method, "Revoke") {
+		return parsedParams[:n]
+	}
+	var opts *state.TxOptions
+	if len(params) > n {
+		opts = new(state.TxOptions)
+		if err := json.Unmarshal([]byte(params[n]), opts); err != nil {
+			panic(fmt.Errorf("error parsing transaction options: %w", err))
+		}
+	}
+	return append(parsedParams[:n], opts)
+}
+
 func parseAddressFromString(addrStr string) (state.Address, error) {
 	var addr state.AccAddress
 	addr, err := types.AccAddressFromBech32(addrStr)
@@ -302,6 +437,36 @@ func parseAddressFromString(addrStr string) (state.Address, error) {
 	return addr, nil
 }
 
+// parseNamespaceID decodes the given namespace ID that is either hex-encoded with the "0x"
+// prefix or base64-encoded.
+func parseNamespaceID(param string) (namespace.ID, error) {
+	if strings.HasPrefix(param, "0x") {
+		decoded, err := hex.DecodeString(param[2:])
+		if err != nil {
+			return nil, errors.New("error decoding namespace ID: hex string could not be decoded")
+		}
+		return decoded, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(param)
+	if err != nil {
+		return nil, errors.New("error decoding namespace ID: base64 string could not be decoded")
+	}
+	return decoded, nil
+}
+
+// decodeData decodes the given parameter that is either hex-encoded with the "0x" prefix, a quoted
+// utf string or base64-encoded.
+func decodeData(param string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(param, "0x"):
+		return hex.DecodeString(param[2:])
+	case strings.HasPrefix(param, "\""):
+		return []byte(strings.Trim(param, "\"")), nil
+	default:
+		return base64.StdEncoding.DecodeString(param)
+	}
+}
+
 func parseSignatureForHelpstring(methodSig reflect.StructField) string {
 	simplifiedSignature := "("
 	in, out := methodSig.Type.NumIn(), methodSig.Type.NumOut()
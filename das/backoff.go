@@ -9,8 +9,8 @@ var (
 	defaultBackoffInitialInterval = time.Minute
 	// next retry attempt will happen with delay of previous one multiplied by defaultBackoffMultiplier
 	defaultBackoffMultiplier = 4
-	// after defaultBackoffMaxRetryCount amount of attempts retry backoff interval will stop growing
-	// and each retry attempt will produce WARN log
+	// after defaultBackoffMaxRetryCount amount of attempts the header is given up and not retried
+	// anymore
 	defaultBackoffMaxRetryCount = 4
 )
 
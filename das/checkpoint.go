@@ -7,10 +7,26 @@ import (
 type checkpoint struct {
 	SampleFrom  uint64 `json:"sample_from"`
 	NetworkHead uint64 `json:"network_head"`
+	// BackwardHead and BackwardNext bound the range of headers sampled backwards from the top and
+	// the bottom respectively. They are empty if sampling goes forward.
+	BackwardHead uint64 `json:"backward_head,omitempty"`
+	BackwardNext uint64 `json:"backward_next,omitempty"`
 	// Failed heights will be retried
 	Failed map[uint64]int `json:"failed,omitempty"`
+	// Unavailable lists the Failed heights, which data every sampling attempt confirmed to be
+	// unavailable
+	Unavailable []uint64 `json:"unavailable,omitempty"`
+	// GivenUp heights exhausted all the sampling attempts and will not be retried
+	GivenUp map[uint64]GivenUpHeight `json:"given_up,omitempty"`
 	// Workers will resume on restart from previous state
 	Workers []workerCheckpoint `json:"workers,omitempty"`
+	// Resample ranges are requested for re-sampling, but were not yet submitted to workers
+	Resample []HeightRange `json:"resample,omitempty"`
+	// ResampleBelow is the height below which headers sampled before the checkpoint was reset are
+	// sampled again
+	ResampleBelow uint64 `json:"resample_below,omitempty"`
+	// Paused indicates that no new sampling jobs should be scheduled
+	Paused bool `json:"paused,omitempty"`
 }
 
 // workerCheckpoint will be used to resume worker on restart
@@ -30,23 +46,55 @@ func newCheckpoint(stats SamplingStats) checkpoint {
 		})
 	}
 	return checkpoint{
-		SampleFrom:  stats.CatchupHead + 1,
-		NetworkHead: stats.NetworkHead,
-		Failed:      stats.Failed,
-		Workers:     workers,
+		SampleFrom:    stats.CatchupHead + 1,
+		NetworkHead:   stats.NetworkHead,
+		BackwardHead:  stats.BackwardHead,
+		BackwardNext:  stats.BackwardNext,
+		Failed:        stats.Failed,
+		Unavailable:   stats.Unavailable,
+		GivenUp:       stats.GivenUp,
+		Workers:       workers,
+		Resample:      stats.Resample,
+		ResampleBelow: stats.ResampleBelow,
+		Paused:        stats.Paused,
+	}
+}
+
+// startBackwards makes sampling start from the network head backwards, leaving newer headers to
+// the catchup.
+func (c *checkpoint) startBackwards() {
+	c.BackwardHead, c.BackwardNext = c.NetworkHead, c.NetworkHead
+	if c.SampleFrom <= c.NetworkHead {
+		c.SampleFrom = c.NetworkHead + 1
 	}
 }
 
 func (c checkpoint) String() string {
 	str := fmt.Sprintf("SampleFrom: %v, NetworkHead: %v", c.SampleFrom, c.NetworkHead)
 
+	if c.BackwardHead > 0 {
+		str += fmt.Sprintf(", BackwardHead: %v, BackwardNext: %v", c.BackwardHead, c.BackwardNext)
+	}
+
 	if len(c.Workers) > 0 {
 		str += fmt.Sprintf(", Workers: %v", len(c.Workers))
 	}
 
+	if c.Paused {
+		str += ", Paused"
+	}
+
+	if len(c.Resample) > 0 {
+		str += fmt.Sprintf(", Resample: %v", c.Resample)
+	}
+
 	if len(c.Failed) > 0 {
 		str += fmt.Sprintf("\nFailed: %v", c.Failed)
 	}
 
+	if len(c.GivenUp) > 0 {
+		str += fmt.Sprintf("\nGivenUp: %v", c.GivenUp)
+	}
+
 	return str
 }
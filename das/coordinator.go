@@ -13,8 +13,9 @@ import (
 
 // samplingCoordinator runs and coordinates sampling workers and updates current sampling state
 type samplingCoordinator struct {
-	concurrencyLimit int
-	samplingTimeout  time.Duration
+	concurrencyLimit         int
+	priorityConcurrencyLimit int
+	samplingTimeout          time.Duration
 
 	getter      libhead.Getter[*header.ExtendedHeader]
 	sampleFn    sampleFn
@@ -29,6 +30,9 @@ type samplingCoordinator struct {
 	// waitCh signals to block coordinator for external access to state
 	waitCh chan *sync.WaitGroup
 
+	// onGivenUp is called for every height given up after exhausting all the retry attempts
+	onGivenUp func(context.Context, GivenUpHeight)
+
 	workersWg sync.WaitGroup
 	metrics   *metrics
 	done
@@ -38,7 +42,11 @@ type samplingCoordinator struct {
 type result struct {
 	job
 	failed map[uint64]int
-	err    error
+	// unavailable marks failed heights, which data was confirmed to be unavailable
+	unavailable map[uint64]bool
+	// timedOut marks failed heights, which sampling did not finish in time
+	timedOut map[uint64]bool
+	err      error
 }
 
 func newSamplingCoordinator(
@@ -48,16 +56,17 @@ func newSamplingCoordinator(
 	broadcast shrexsub.BroadcastFn,
 ) *samplingCoordinator {
 	return &samplingCoordinator{
-		concurrencyLimit: params.ConcurrencyLimit,
-		samplingTimeout:  params.SampleTimeout,
-		getter:           getter,
-		sampleFn:         sample,
-		broadcastFn:      broadcast,
-		state:            newCoordinatorState(params),
-		resultCh:         make(chan result),
-		updHeadCh:        make(chan *header.ExtendedHeader),
-		waitCh:           make(chan *sync.WaitGroup),
-		done:             newDone("sampling coordinator"),
+		concurrencyLimit:         params.ConcurrencyLimit,
+		priorityConcurrencyLimit: params.PriorityConcurrencyLimit,
+		samplingTimeout:          params.SampleTimeout,
+		getter:                   getter,
+		sampleFn:                 sample,
+		broadcastFn:              broadcast,
+		state:                    newCoordinatorState(params),
+		resultCh:                 make(chan result),
+		updHeadCh:                make(chan *header.ExtendedHeader),
+		waitCh:                   make(chan *sync.WaitGroup),
+		done:                     newDone("sampling coordinator"),
 	}
 }
 
@@ -70,24 +79,30 @@ func (sc *samplingCoordinator) run(ctx context.Context, cp checkpoint) {
 	}
 
 	for {
-		for !sc.concurrencyLimitReached() {
-			next, found := sc.state.nextJob()
-			if !found {
-				break
-			}
-			sc.runWorker(ctx, next)
-		}
+		sc.schedule(ctx)
 
 		select {
 		case head := <-sc.updHeadCh:
 			if sc.state.isNewHead(head.Height()) {
-				sc.runWorker(ctx, sc.state.recentJob(head))
+				if sc.state.priority.enabled() {
+					// queue for the priority workers reserved for recent headers
+					if dropped := sc.state.priority.push(head); dropped {
+						sc.metrics.observePriorityDropped(ctx)
+					}
+				} else if !sc.state.paused {
+					// run worker without concurrency limit restrictions to reduced delay
+					sc.runWorker(ctx, sc.state.recentJob(head))
+				}
 				sc.state.updateHead(head.Height())
-				// run worker without concurrency limit restrictions to reduced delay
 				sc.metrics.observeNewHead(ctx)
 			}
 		case res := <-sc.resultCh:
-			sc.state.handleResult(res)
+			for _, gu := range sc.state.handleResult(res) {
+				sc.metrics.observeGivenUp(ctx, gu)
+				if sc.onGivenUp != nil {
+					sc.onGivenUp(ctx, gu)
+				}
+			}
 		case wg := <-sc.waitCh:
 			wg.Wait()
 		case <-ctx.Done():
@@ -98,6 +113,31 @@ func (sc *samplingCoordinator) run(ctx context.Context, cp checkpoint) {
 	}
 }
 
+// schedule runs workers for the next jobs until concurrency limits are reached, unless the
+// coordinator is paused
+func (sc *samplingCoordinator) schedule(ctx context.Context) {
+	if sc.state.paused {
+		return
+	}
+
+	for !sc.priorityConcurrencyLimitReached() {
+		next, waited, found := sc.state.priorityJob()
+		if !found {
+			break
+		}
+		sc.metrics.observePriorityWait(ctx, waited)
+		sc.runWorker(ctx, next)
+	}
+
+	for !sc.concurrencyLimitReached() {
+		next, found := sc.state.nextJob()
+		if !found {
+			break
+		}
+		sc.runWorker(ctx, next)
+	}
+}
+
 // runWorker runs job in separate worker go-routine
 func (sc *samplingCoordinator) runWorker(ctx context.Context, j job) {
 	w := newWorker(j, sc.getter, sc.sampleFn, sc.broadcastFn, sc.metrics)
@@ -134,6 +174,22 @@ func (sc *samplingCoordinator) stats(ctx context.Context) (SamplingStats, error)
 	return sc.state.unsafeStats(), nil
 }
 
+// modify pauses the coordinator to apply the given change to the state in a concurrently safe
+// manner
+func (sc *samplingCoordinator) modify(ctx context.Context, change func(*coordinatorState) error) error {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Done()
+
+	select {
+	case sc.waitCh <- &wg:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return change(&sc.state)
+}
+
 func (sc *samplingCoordinator) getCheckpoint(ctx context.Context) (checkpoint, error) {
 	stats, err := sc.stats(ctx)
 	if err != nil {
@@ -142,7 +198,13 @@ func (sc *samplingCoordinator) getCheckpoint(ctx context.Context) (checkpoint, e
 	return newCheckpoint(stats), nil
 }
 
-// concurrencyLimitReached indicates whether concurrencyLimit has been reached
+// concurrencyLimitReached indicates whether concurrencyLimit has been reached. Priority workers are
+// not counted towards it.
 func (sc *samplingCoordinator) concurrencyLimitReached() bool {
-	return len(sc.state.inProgress) >= sc.concurrencyLimit
+	return len(sc.state.inProgress)-sc.state.inPriority >= sc.concurrencyLimit
+}
+
+// priorityConcurrencyLimitReached indicates whether all the priority workers are busy
+func (sc *samplingCoordinator) priorityConcurrencyLimitReached() bool {
+	return sc.state.inPriority >= sc.priorityConcurrencyLimit
 }
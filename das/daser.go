@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"sync/atomic"
+	"time"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 
@@ -14,12 +16,33 @@ import (
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
 var log = logging.Logger("das")
 
+// ErrNoSamplingHistory is returned by SampledRange when the used share.Availability does not
+// persist the results of sampling.
+var ErrNoSamplingHistory = errors.New("das: sampling history is not recorded")
+
+// ErrUnavailableData is returned by Start, if the DASer was halted after confirming unavailability
+// of data with HaltOnUnavailable set.
+var ErrUnavailableData = errors.New("das: data confirmed to be unavailable")
+
+// ErrNoSamplingResult is returned by SamplingResult when no result is recorded for the height.
+var ErrNoSamplingResult = errors.New("das: no sampling result recorded for the height")
+
+// samplingResultsCacheSize is the amount of the most recent share.SamplingResults kept in memory.
+const samplingResultsCacheSize = 1024
+
+// samplingHistory is implemented by the share.Availability that persists the results of sampling
+// indexed by height.
+type samplingHistory interface {
+	SampledRange(ctx context.Context, from, to uint64) ([]*cache.SamplingRecord, error)
+}
+
 // DASer continuously validates availability of data committed to headers.
 type DASer struct {
 	params Parameters
@@ -32,6 +55,10 @@ type DASer struct {
 	sampler    *samplingCoordinator
 	store      checkpointStore
 	subscriber subscriber
+	// results keeps the most recent share.SamplingResults keyed by height
+	results *lru.Cache
+	// givenUpSubs are notified about heights given up after exhausting all the sampling attempts
+	givenUpSubs *givenUpSubscriptions
 
 	cancel         context.CancelFunc
 	subscriberDone chan struct{}
@@ -51,6 +78,11 @@ func NewDASer(
 	shrexBroadcast shrexsub.BroadcastFn,
 	options ...Option,
 ) (*DASer, error) {
+	results, err := lru.New(samplingResultsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	d := &DASer{
 		params:         DefaultParameters(),
 		da:             da,
@@ -59,6 +91,8 @@ func NewDASer(
 		getter:         getter,
 		store:          newCheckpointStore(dstore),
 		subscriber:     newSubscriber(),
+		results:        results,
+		givenUpSubs:    newGivenUpSubscriptions(),
 		subscriberDone: make(chan struct{}),
 	}
 
@@ -66,12 +100,13 @@ func NewDASer(
 		applyOpt(d)
 	}
 
-	err := d.params.Validate()
+	err = d.params.Validate()
 	if err != nil {
 		return nil, err
 	}
 
 	d.sampler = newSamplingCoordinator(d.params, getter, d.sample, shrexBroadcast)
+	d.sampler.onGivenUp = d.handleGivenUp
 	return d, nil
 }
 
@@ -101,9 +136,23 @@ func (d *DASer) Start(ctx context.Context) error {
 		if h, err := d.getter.Head(ctx); err == nil {
 			cp.NetworkHead = uint64(h.Height())
 		}
+		// the direction is recorded by the checkpoint, so existing checkpoints keep going forward
+		if d.params.SampleBackwards {
+			cp.startBackwards()
+		}
 	}
 	log.Info("starting DASer from checkpoint: ", cp.String())
 
+	if d.params.HaltOnUnavailable {
+		for h, gu := range cp.GivenUp {
+			if gu.Unavailable {
+				atomic.StoreInt32(&d.running, 0)
+				sub.Cancel()
+				return fmt.Errorf("%w: height %d", ErrUnavailableData, h)
+			}
+		}
+	}
+
 	runCtx, cancel := context.WithCancel(context.Background())
 	d.cancel = cancel
 
@@ -147,7 +196,7 @@ func (d *DASer) Stop(ctx context.Context) error {
 }
 
 func (d *DASer) sample(ctx context.Context, h *header.ExtendedHeader) error {
-	err := d.da.SharesAvailable(ctx, h.DAH)
+	err := d.sharesAvailable(cache.WithHeight(ctx, uint64(h.Height())), h)
 	if err != nil {
 		var byzantineErr *byzantine.ErrByzantine
 		if errors.As(err, &byzantineErr) {
@@ -162,6 +211,125 @@ func (d *DASer) sample(ctx context.Context, h *header.ExtendedHeader) error {
 	return nil
 }
 
+// sharesAvailable validates availability of the header's data and records the share.SamplingResult,
+// if the used share.Availability reports it.
+func (d *DASer) sharesAvailable(ctx context.Context, h *header.ExtendedHeader) error {
+	ra, ok := d.da.(share.ReportingAvailability)
+	if !ok {
+		return d.da.SharesAvailable(ctx, h.DAH)
+	}
+
+	result, err := ra.SharesAvailableWithResult(ctx, h.DAH)
+	if result == nil || len(result.Samples) == 0 {
+		return err
+	}
+	d.results.Add(uint64(h.Height()), result)
+	d.sampler.metrics.observeSamplingResult(ctx, result)
+	if err != nil {
+		for _, s := range result.Failed() {
+			log.Debugw("sample failed",
+				"height", h.Height(),
+				"row", s.Coordinate.Row,
+				"col", s.Coordinate.Col,
+				"source", s.Source,
+				"latency", s.Latency,
+				"err", s.Error)
+		}
+	}
+	return err
+}
+
+// handleGivenUp notifies the subscribers about the given up height and halts sampling, if the data
+// was confirmed to be unavailable and HaltOnUnavailable is set.
+func (d *DASer) handleGivenUp(_ context.Context, gu GivenUpHeight) {
+	d.givenUpSubs.publish(gu)
+	if !gu.Unavailable || !d.params.HaltOnUnavailable {
+		return
+	}
+
+	log.Errorw("data confirmed to be unavailable, halting sampling", "height", gu.Height)
+	// the coordinator calling this must not wait for itself to stop
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := d.Stop(ctx); err != nil {
+			log.Errorw("halting DASer", "err", err)
+		}
+	}()
+}
+
+// SubscribeGivenUp returns a channel receiving heights given up after exhausting all the sampling
+// attempts. The channel is closed once the context is canceled.
+func (d *DASer) SubscribeGivenUp(ctx context.Context) (<-chan GivenUpHeight, error) {
+	return d.givenUpSubs.subscribe(ctx), nil
+}
+
+// SamplingResult returns the share.SamplingResult of the most recent sampling of the header at the
+// given height. Only the results of recently sampled headers are kept.
+func (d *DASer) SamplingResult(_ context.Context, height uint64) (*share.SamplingResult, error) {
+	result, ok := d.results.Get(height)
+	if !ok {
+		return nil, ErrNoSamplingResult
+	}
+	return result.(*share.SamplingResult), nil
+}
+
+// Pause stops scheduling of new sampling jobs until Resume is called. Jobs in progress are
+// finished. The paused state persists through restarts.
+func (d *DASer) Pause(ctx context.Context) error {
+	return d.control(ctx, func(s *coordinatorState) error {
+		s.paused = true
+		return nil
+	})
+}
+
+// Resume resumes sampling paused with Pause.
+func (d *DASer) Resume(ctx context.Context) error {
+	return d.control(ctx, func(s *coordinatorState) error {
+		s.paused = false
+		return nil
+	})
+}
+
+// ResampleRange schedules sampling of the headers within the given inclusive range of heights,
+// even if they were successfully sampled before.
+func (d *DASer) ResampleRange(ctx context.Context, from, to uint64) error {
+	return d.control(ctx, func(s *coordinatorState) error {
+		return s.requestResample(from, to)
+	})
+}
+
+// ResetCheckpoint starts sampling over from the given height, forgetting about failed heights and
+// requested re-sampling. Zero height resets to the configured SampleFrom. In the backwards mode,
+// sampling starts over from the network head instead. Headers sampled before the reset are sampled
+// again, even if they were successfully sampled before. Jobs in progress are finished.
+func (d *DASer) ResetCheckpoint(ctx context.Context, sampleFrom uint64) error {
+	if sampleFrom == 0 {
+		sampleFrom = d.params.SampleFrom
+	}
+	return d.control(ctx, func(s *coordinatorState) error {
+		s.reset(sampleFrom)
+		return nil
+	})
+}
+
+// control applies the change to the sampling state and persists the resulting checkpoint.
+func (d *DASer) control(ctx context.Context, change func(*coordinatorState) error) error {
+	if atomic.LoadInt32(&d.running) == 0 {
+		return errors.New("das: DASer is not running")
+	}
+
+	if err := d.sampler.modify(ctx, change); err != nil {
+		return err
+	}
+
+	cp, err := d.sampler.getCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	return d.store.store(ctx, cp)
+}
+
 // SamplingStats returns the current statistics over the DA sampling process.
 func (d *DASer) SamplingStats(ctx context.Context) (SamplingStats, error) {
 	return d.sampler.stats(ctx)
@@ -171,3 +339,13 @@ func (d *DASer) SamplingStats(ctx context.Context) (SamplingStats, error) {
 func (d *DASer) WaitCatchUp(ctx context.Context) error {
 	return d.sampler.state.waitCatchUp(ctx)
 }
+
+// SampledRange returns the records of successfully sampled headers within the given inclusive
+// range of heights.
+func (d *DASer) SampledRange(ctx context.Context, from, to uint64) ([]*cache.SamplingRecord, error) {
+	history, ok := d.da.(samplingHistory)
+	if !ok {
+		return nil, ErrNoSamplingHistory
+	}
+	return history.SampledRange(ctx, from, to)
+}
@@ -2,6 +2,8 @@ package das
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/availability/full"
 	"github.com/celestiaorg/celestia-node/share/availability/light"
 	"github.com/celestiaorg/celestia-node/share/availability/mocks"
@@ -74,6 +77,12 @@ func TestDASerLifecycle(t *testing.T) {
 
 	// give catch-up routine a second to finish up sampling last header
 	assert.NoError(t, daser.sampler.state.waitCatchUp(ctx))
+
+	// light availability reports the outcome of every sample taken
+	result, err := daser.SamplingResult(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, result.Samples, int(light.DefaultSampleAmount))
+	assert.Empty(t, result.Failed())
 }
 
 func TestDASer_Restart(t *testing.T) {
@@ -189,6 +198,199 @@ func TestDASer_stopsAfter_BEFP(t *testing.T) {
 	require.True(t, daser.running == 0)
 }
 
+func TestDASer_Control(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	bServ := mdutils.Bserv()
+	avail := light.TestAvailability(getters.NewIPLDGetter(bServ))
+	mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 15, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	daser, err := NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1))
+	require.NoError(t, err)
+	// control is only possible over the running DASer
+	require.Error(t, daser.Pause(ctx))
+
+	require.NoError(t, daser.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, daser.Stop(ctx))
+	})
+	require.NoError(t, daser.WaitCatchUp(ctx))
+
+	require.NoError(t, daser.Pause(ctx))
+	// the paused state is persisted
+	cp, err := daser.store.load(ctx)
+	require.NoError(t, err)
+	assert.True(t, cp.Paused)
+
+	// requested re-sampling waits for resume
+	require.NoError(t, daser.ResampleRange(ctx, 1, 5))
+	stats, err := daser.SamplingStats(ctx)
+	require.NoError(t, err)
+	assert.True(t, stats.Paused)
+	assert.Equal(t, []HeightRange{{From: 1, To: 5}}, stats.Resample)
+
+	require.NoError(t, daser.Resume(ctx))
+	require.NoError(t, daser.WaitCatchUp(ctx))
+
+	require.NoError(t, daser.ResetCheckpoint(ctx, 10))
+	cp, err = daser.store.load(ctx)
+	require.NoError(t, err)
+	assert.False(t, cp.Paused)
+	assert.Empty(t, cp.Resample)
+	require.NoError(t, daser.WaitCatchUp(ctx))
+}
+
+// TestDASer_SampleBackwards tests that sampling goes backwards only when started without a
+// checkpoint, while a checkpoint saved going forward keeps going forward.
+func TestDASer_SampleBackwards(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	t.Run("fresh", func(t *testing.T) {
+		ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+		bServ := mdutils.Bserv()
+		avail := light.TestAvailability(getters.NewIPLDGetter(bServ))
+		mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 15, 0)
+
+		daser, err := NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1),
+			WithSampleBackwards(true))
+		require.NoError(t, err)
+		require.NoError(t, daser.Start(ctx))
+		require.NoError(t, daser.WaitCatchUp(ctx))
+		require.NoError(t, daser.Stop(ctx))
+
+		cp, err := daser.store.load(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 15, cp.BackwardHead)
+		assert.EqualValues(t, 16, cp.SampleFrom)
+	})
+
+	t.Run("resume forward checkpoint", func(t *testing.T) {
+		ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+		bServ := mdutils.Bserv()
+		avail := light.TestAvailability(getters.NewIPLDGetter(bServ))
+		mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 15, 0)
+
+		daser, err := NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1),
+			WithSampleBackwards(true))
+		require.NoError(t, err)
+		err = daser.store.store(ctx, checkpoint{SampleFrom: 6, NetworkHead: 15})
+		require.NoError(t, err)
+
+		require.NoError(t, daser.Start(ctx))
+		require.NoError(t, daser.WaitCatchUp(ctx))
+		stats, err := daser.SamplingStats(ctx)
+		require.NoError(t, err)
+		require.NoError(t, daser.Stop(ctx))
+
+		// the headers from the checkpoint up to the head were sampled going forward
+		assert.Zero(t, stats.BackwardHead)
+		assert.EqualValues(t, 15, stats.SampledChainHead)
+		assert.EqualValues(t, 15, stats.CatchupHead)
+	})
+}
+
+// TestDASer_ResetResamples tests that the headers sampled before the checkpoint reset are sampled
+// again instead of being served from the cache.
+func TestDASer_ResetResamples(t *testing.T) {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	bServ := mdutils.Bserv()
+	avail := &countingAvailability{Availability: light.TestAvailability(getters.NewIPLDGetter(bServ))}
+	cached := cache.NewShareAvailability(avail, ds)
+	mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 15, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	daser, err := NewDASer(cached, sub, mockGet, ds, mockService, newBroadcastMock(1))
+	require.NoError(t, err)
+	require.NoError(t, daser.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, daser.Stop(ctx))
+	})
+	require.NoError(t, daser.WaitCatchUp(ctx))
+	assert.EqualValues(t, 15, avail.count.Load())
+
+	require.NoError(t, daser.ResetCheckpoint(ctx, 1))
+	require.NoError(t, daser.WaitCatchUp(ctx))
+	assert.EqualValues(t, 30, avail.count.Load())
+}
+
+func TestDASer_HaltOnUnavailable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	bServ := mdutils.Bserv()
+	avail := light.TestAvailability(getters.NewIPLDGetter(bServ))
+	mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 15, 0)
+
+	daser, err := NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1),
+		WithHaltOnUnavailable(true))
+	require.NoError(t, err)
+
+	err = daser.store.store(ctx, checkpoint{
+		SampleFrom:  16,
+		NetworkHead: 15,
+		GivenUp:     map[uint64]GivenUpHeight{7: {Height: 7, Attempts: 5, Unavailable: true}},
+	})
+	require.NoError(t, err)
+
+	err = daser.Start(ctx)
+	require.ErrorIs(t, err, ErrUnavailableData)
+	require.Error(t, daser.Pause(ctx))
+}
+
+// TestDASer_HaltOnUnavailable_Timeout tests that headers given up after sampling timeouts are not
+// considered unavailable and do not halt sampling.
+func TestDASer_HaltOnUnavailable_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	bServ := mdutils.Bserv()
+	mockGet, sub, mockService := createDASerSubcomponents(t, bServ, 1, 0)
+	avail := mocks.NewMockAvailability(gomock.NewController(t))
+	// the way light and full availability report timeouts
+	avail.EXPECT().SharesAvailable(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(sampleCtx context.Context, _ *share.Root) error {
+			<-sampleCtx.Done()
+			return fmt.Errorf("%w: %w", share.ErrNotAvailable, sampleCtx.Err())
+		}).AnyTimes()
+
+	daser, err := NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1),
+		WithHaltOnUnavailable(true), WithSampleTimeout(time.Millisecond))
+	require.NoError(t, err)
+	daser.sampler.state.retryStrategy = newRetryStrategy([]time.Duration{0})
+
+	givenUp, err := daser.SubscribeGivenUp(ctx)
+	require.NoError(t, err)
+	require.NoError(t, daser.Start(ctx))
+
+	select {
+	case gu := <-givenUp:
+		assert.EqualValues(t, 1, gu.Height)
+		assert.True(t, gu.TimedOut)
+		assert.False(t, gu.Unavailable)
+	case <-ctx.Done():
+		t.Fatal("height was not given up in time")
+	}
+
+	require.NoError(t, daser.Stop(ctx))
+	cp, err := daser.store.load(ctx)
+	require.NoError(t, err)
+	require.Contains(t, cp.GivenUp, uint64(1))
+
+	// sampling is not refused to start with the given up height
+	daser, err = NewDASer(avail, sub, mockGet, ds, mockService, newBroadcastMock(1),
+		WithHaltOnUnavailable(true), WithSampleTimeout(time.Millisecond))
+	require.NoError(t, err)
+	require.NoError(t, daser.Start(ctx))
+	require.NoError(t, daser.Stop(ctx))
+}
+
 func TestDASerSampleTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	t.Cleanup(cancel)
@@ -338,6 +540,17 @@ func (m *mockGetter) GetByHeight(_ context.Context, height uint64) (*header.Exte
 	return m.headers[int64(height)], nil
 }
 
+// countingAvailability counts the calls to the wrapped share.Availability.
+type countingAvailability struct {
+	share.Availability
+	count atomic.Int32
+}
+
+func (ca *countingAvailability) SharesAvailable(ctx context.Context, root *share.Root) error {
+	ca.count.Add(1)
+	return ca.Availability.SharesAvailable(ctx, root)
+}
+
 type benchGetterStub struct {
 	getterStub
 	header *header.ExtendedHeader
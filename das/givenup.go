@@ -0,0 +1,63 @@
+package das
+
+import (
+	"context"
+	"sync"
+)
+
+// givenUpBufferSize is the amount of GivenUpHeight events buffered for a slow subscriber before
+// the new events are dropped for it.
+const givenUpBufferSize = 16
+
+// GivenUpHeight describes a header, sampling of which failed after exhausting all the retry
+// attempts. Such headers are not retried anymore, unless requested explicitly.
+type GivenUpHeight struct {
+	Height   uint64 `json:"height"`
+	Attempts int    `json:"attempts"`
+	// Unavailable indicates that every attempt confirmed the data to be unavailable, rather than
+	// failing for any other reason.
+	Unavailable bool `json:"unavailable"`
+	// TimedOut indicates that the last attempt did not finish in time, which may be caused by slow or
+	// missing peers rather than by unavailable data.
+	TimedOut bool `json:"timed_out,omitempty"`
+}
+
+// givenUpSubscriptions fans out GivenUpHeight events to the subscribers.
+type givenUpSubscriptions struct {
+	lk   sync.Mutex
+	subs map[chan GivenUpHeight]struct{}
+}
+
+func newGivenUpSubscriptions() *givenUpSubscriptions {
+	return &givenUpSubscriptions{subs: make(map[chan GivenUpHeight]struct{})}
+}
+
+// subscribe returns a channel receiving GivenUpHeight events until the context is canceled.
+func (s *givenUpSubscriptions) subscribe(ctx context.Context) <-chan GivenUpHeight {
+	ch := make(chan GivenUpHeight, givenUpBufferSize)
+	s.lk.Lock()
+	s.subs[ch] = struct{}{}
+	s.lk.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.lk.Lock()
+		delete(s.subs, ch)
+		s.lk.Unlock()
+		close(ch)
+	}()
+	return ch
+}
+
+// publish sends the event to every subscriber without blocking.
+func (s *givenUpSubscriptions) publish(gu GivenUpHeight) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	for ch := range s.subs {
+		select {
+		case ch <- gu:
+		default:
+			log.Warnw("given up height subscriber is too slow, dropping event", "height", gu.Height)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/metric/instrument/syncint64"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
 )
 
 const (
@@ -28,9 +29,15 @@ var (
 type metrics struct {
 	sampled       syncint64.Counter
 	sampleTime    syncfloat64.Histogram
+	shareLatency  syncfloat64.Histogram
 	getHeaderTime syncfloat64.Histogram
 	newHead       syncint64.Counter
 
+	priorityWait    syncfloat64.Histogram
+	priorityDropped syncint64.Counter
+
+	givenUp syncint64.Counter
+
 	lastSampledTS uint64
 }
 
@@ -47,6 +54,12 @@ func (d *DASer) InitMetrics() error {
 		return err
 	}
 
+	shareLatency, err := meter.SyncFloat64().Histogram("das_share_latency_hist",
+		instrument.WithDescription("duration of retrieving a single sampled share"))
+	if err != nil {
+		return err
+	}
+
 	getHeaderTime, err := meter.SyncFloat64().Histogram("das_get_header_time_hist",
 		instrument.WithDescription("duration of getting header from header store"))
 	if err != nil {
@@ -59,6 +72,30 @@ func (d *DASer) InitMetrics() error {
 		return err
 	}
 
+	priorityWait, err := meter.SyncFloat64().Histogram("das_priority_queue_wait_time_hist",
+		instrument.WithDescription("duration recent headers waited in the priority queue"))
+	if err != nil {
+		return err
+	}
+
+	priorityDropped, err := meter.SyncInt64().Counter("das_priority_queue_dropped_counter",
+		instrument.WithDescription("amount of recent headers dropped from the full priority queue"))
+	if err != nil {
+		return err
+	}
+
+	givenUp, err := meter.SyncInt64().Counter("das_given_up_headers_counter",
+		instrument.WithDescription("amount of headers given up after exhausting all sampling attempts"))
+	if err != nil {
+		return err
+	}
+
+	priorityQueue, err := meter.AsyncInt64().Gauge("das_priority_queue_size",
+		instrument.WithDescription("amount of recent headers waiting in the priority queue"))
+	if err != nil {
+		return err
+	}
+
 	lastSampledTS, err := meter.AsyncInt64().Gauge("das_latest_sampled_ts",
 		instrument.WithDescription("latest sampled timestamp"))
 	if err != nil {
@@ -96,8 +133,14 @@ func (d *DASer) InitMetrics() error {
 	d.sampler.metrics = &metrics{
 		sampled:       sampled,
 		sampleTime:    sampleTime,
+		shareLatency:  shareLatency,
 		getHeaderTime: getHeaderTime,
 		newHead:       newHead,
+
+		priorityWait:    priorityWait,
+		priorityDropped: priorityDropped,
+
+		givenUp: givenUp,
 	}
 
 	err = meter.RegisterCallback(
@@ -107,6 +150,7 @@ func (d *DASer) InitMetrics() error {
 			networkHead,
 			sampledChainHead,
 			totalSampled,
+			priorityQueue,
 		},
 		func(ctx context.Context) {
 			stats, err := d.sampler.stats(ctx)
@@ -127,6 +171,7 @@ func (d *DASer) InitMetrics() error {
 			}
 
 			totalSampled.Observe(ctx, int64(stats.totalSampled()))
+			priorityQueue.Observe(ctx, int64(stats.PriorityQueue))
 		},
 	)
 
@@ -164,6 +209,18 @@ func (m *metrics) observeSample(
 	atomic.StoreUint64(&m.lastSampledTS, uint64(time.Now().UTC().Unix()))
 }
 
+// observeSamplingResult records the latency of every sample taken over a header.
+func (m *metrics) observeSamplingResult(ctx context.Context, result *share.SamplingResult) {
+	if m == nil {
+		return
+	}
+	for _, s := range result.Samples {
+		m.shareLatency.Record(ctx, s.Latency.Seconds(),
+			attribute.Bool(failedLabel, !s.Success),
+		)
+	}
+}
+
 // observeGetHeader records the time it took to get a header from the header store.
 func (m *metrics) observeGetHeader(ctx context.Context, d time.Duration) {
 	if m == nil {
@@ -179,3 +236,29 @@ func (m *metrics) observeNewHead(ctx context.Context) {
 	}
 	m.newHead.Add(ctx, 1)
 }
+
+// observePriorityWait records the time a recent header waited in the priority queue.
+func (m *metrics) observePriorityWait(ctx context.Context, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.priorityWait.Record(ctx, d.Seconds())
+}
+
+// observePriorityDropped records a recent header dropped from the full priority queue.
+func (m *metrics) observePriorityDropped(ctx context.Context) {
+	if m == nil {
+		return
+	}
+	m.priorityDropped.Add(ctx, 1)
+}
+
+// observeGivenUp records a header given up after exhausting all sampling attempts.
+func (m *metrics) observeGivenUp(ctx context.Context, gu GivenUpHeight) {
+	if m == nil {
+		return
+	}
+	m.givenUp.Add(ctx, 1,
+		attribute.Bool("unavailable", gu.Unavailable),
+		attribute.Bool("timed_out", gu.TimedOut))
+}
@@ -40,6 +40,32 @@ type Parameters struct {
 	// divided between parallel workers. SampleTimeout should be adjusted proportionally to
 	// ConcurrencyLimit.
 	SampleTimeout time.Duration
+
+	// PriorityQueueSize is the maximum amount of recent headers received from the network that are
+	// queued for sampling by the priority workers. Once the queue is full, the oldest queued header
+	// is left to be sampled by catchup workers.
+	PriorityQueueSize int
+
+	// PriorityConcurrencyLimit defines the amount of workers reserved for sampling of recent headers.
+	// Those workers are not counted towards ConcurrencyLimit.
+	PriorityConcurrencyLimit int
+
+	// HaltOnUnavailable stops sampling once a header is given up after confirming its data to be
+	// unavailable on every sampling attempt, similarly to how sampling is stopped on a fraud proof.
+	// Headers given up after any of the attempts timed out or failed otherwise do not halt sampling. Sampling refuses to start again
+	// with the option set, so it has to be disabled to recover by re-sampling the height or
+	// resetting the checkpoint.
+	HaltOnUnavailable bool
+
+	// SampleBackwards makes sampling start from the network head and go backwards to older headers,
+	// so that the recent headers are covered first. It only applies once no previous checkpoint
+	// was saved, as the checkpoint records the direction sampling was started in. Sampling resumed
+	// from a checkpoint saved while going forward keeps going forward.
+	SampleBackwards bool
+
+	// SamplingWindow is the amount of most recent headers sampled. Older headers are skipped.
+	// SamplingWindow = 0 samples all the headers from SampleFrom.
+	SamplingWindow uint64
 }
 
 // DefaultParameters returns the default configuration values for the daser parameters
@@ -53,7 +79,9 @@ func DefaultParameters() Parameters {
 		BackgroundStoreInterval: 10 * time.Minute,
 		SampleFrom:              1,
 		// SampleTimeout = block time * max amount of catchup workers
-		SampleTimeout: 15 * time.Second * time.Duration(concurrencyLimit),
+		SampleTimeout:            15 * time.Second * time.Duration(concurrencyLimit),
+		PriorityQueueSize:        concurrencyLimit * 4,
+		PriorityConcurrencyLimit: 2,
 	}
 }
 
@@ -61,7 +89,8 @@ func DefaultParameters() Parameters {
 //
 //	All parameters must be positive and non-zero, except:
 //		BackgroundStoreInterval = 0 disables background storer,
-//		PriorityQueueSize = 0 disables prioritization of recently produced blocks for sampling
+//		PriorityQueueSize = 0 disables prioritization of recently produced blocks for sampling,
+//		SamplingWindow = 0 disables the sampling window
 func (p *Parameters) Validate() error {
 	// SamplingRange = 0 will cause the jobs' queue to be empty
 	// Therefore no sampling jobs will be reserved and more importantly the DASer will break
@@ -98,6 +127,21 @@ func (p *Parameters) Validate() error {
 		)
 	}
 
+	if p.PriorityQueueSize < 0 {
+		return errInvalidOptionValue(
+			"PriorityQueueSize",
+			"negative",
+		)
+	}
+
+	// PriorityConcurrencyLimit = 0 with the enabled queue would never sample the queued headers
+	if p.PriorityQueueSize > 0 && p.PriorityConcurrencyLimit <= 0 {
+		return errInvalidOptionValue(
+			"PriorityConcurrencyLimit",
+			"negative or 0",
+		)
+	}
+
 	return nil
 }
 
@@ -154,3 +198,46 @@ func WithSampleTimeout(sampleTimeout time.Duration) Option {
 		d.params.SampleTimeout = sampleTimeout
 	}
 }
+
+// WithPriorityQueueSize is a functional option to configure the daser's `PriorityQueueSize`
+// parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithPriorityQueueSize(priorityQueueSize int) Option {
+	return func(d *DASer) {
+		d.params.PriorityQueueSize = priorityQueueSize
+	}
+}
+
+// WithPriorityConcurrencyLimit is a functional option to configure the daser's
+// `PriorityConcurrencyLimit` parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithPriorityConcurrencyLimit(priorityConcurrencyLimit int) Option {
+	return func(d *DASer) {
+		d.params.PriorityConcurrencyLimit = priorityConcurrencyLimit
+	}
+}
+
+// WithHaltOnUnavailable is a functional option to configure the daser's `HaltOnUnavailable`
+// parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithHaltOnUnavailable(halt bool) Option {
+	return func(d *DASer) {
+		d.params.HaltOnUnavailable = halt
+	}
+}
+
+// WithSampleBackwards is a functional option to configure the daser's `SampleBackwards` parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithSampleBackwards(backwards bool) Option {
+	return func(d *DASer) {
+		d.params.SampleBackwards = backwards
+	}
+}
+
+// WithSamplingWindow is a functional option to configure the daser's `SamplingWindow` parameter
+// Refer to WithSamplingRange documentation to see an example of how to use this
+func WithSamplingWindow(window uint64) Option {
+	return func(d *DASer) {
+		d.params.SamplingWindow = window
+	}
+}
@@ -0,0 +1,59 @@
+package das
+
+import (
+	"time"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+// priorityQueue keeps recent headers received from the network until a priority worker is
+// available to sample them. The most recent header is sampled first. Once the queue is full, the
+// oldest header is dropped from it and left to be sampled by a catchup job.
+type priorityQueue struct {
+	// size is the maximum amount of queued headers. Zero size disables the queue.
+	size  int
+	items []queuedHeader
+}
+
+type queuedHeader struct {
+	header   *header.ExtendedHeader
+	queuedAt time.Time
+}
+
+func newPriorityQueue(size int) priorityQueue {
+	return priorityQueue{
+		size:  size,
+		items: make([]queuedHeader, 0, size),
+	}
+}
+
+// enabled reports whether recent headers should be queued for the priority workers.
+func (q *priorityQueue) enabled() bool {
+	return q.size > 0
+}
+
+// push queues the header, dropping the oldest queued one if the queue is full. It reports whether
+// a header was dropped.
+func (q *priorityQueue) push(h *header.ExtendedHeader) (dropped bool) {
+	if len(q.items) >= q.size {
+		q.items = q.items[1:]
+		dropped = true
+	}
+	q.items = append(q.items, queuedHeader{header: h, queuedAt: time.Now()})
+	return dropped
+}
+
+// pop returns the most recently queued header.
+func (q *priorityQueue) pop() (queuedHeader, bool) {
+	if len(q.items) == 0 {
+		return queuedHeader{}, false
+	}
+	last := len(q.items) - 1
+	item := q.items[last]
+	q.items = q.items[:last]
+	return item, true
+}
+
+func (q *priorityQueue) len() int {
+	return len(q.items)
+}
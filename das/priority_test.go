@@ -0,0 +1,69 @@
+package das
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+func Test_priorityQueue(t *testing.T) {
+	q := newPriorityQueue(2)
+	require.True(t, q.enabled())
+
+	headers := make([]*header.ExtendedHeader, 3)
+	for i := range headers {
+		headers[i] = &header.ExtendedHeader{}
+		headers[i].RawHeader.Height = int64(i + 1)
+	}
+
+	assert.False(t, q.push(headers[0]))
+	assert.False(t, q.push(headers[1]))
+	// the oldest header is dropped once the queue is full
+	assert.True(t, q.push(headers[2]))
+	assert.Equal(t, 2, q.len())
+
+	// the most recent header goes first
+	item, ok := q.pop()
+	require.True(t, ok)
+	assert.EqualValues(t, 3, item.header.Height())
+	item, ok = q.pop()
+	require.True(t, ok)
+	assert.EqualValues(t, 2, item.header.Height())
+	_, ok = q.pop()
+	assert.False(t, ok)
+
+	disabled := newPriorityQueue(0)
+	assert.False(t, disabled.enabled())
+}
+
+func Test_priorityJob(t *testing.T) {
+	params := DefaultParameters()
+	params.PriorityQueueSize = 4
+	sc := newSamplingCoordinator(params, getterStub{}, nil, nil)
+	sc.state.networkHead = 10
+	sc.state.next = 11
+
+	h := &header.ExtendedHeader{}
+	h.RawHeader.Height = 11
+	sc.state.priority.push(h)
+
+	j, _, found := sc.state.priorityJob()
+	require.True(t, found)
+	assert.True(t, j.priority)
+	assert.Equal(t, recentJob, j.jobType)
+	// catchup must not process the same height
+	assert.EqualValues(t, 12, sc.state.next)
+
+	// priority workers are not counted towards the concurrency limit
+	sc.state.putInProgress(j.id, nil)
+	assert.False(t, sc.concurrencyLimitReached())
+	sc.priorityConcurrencyLimit = 1
+	assert.True(t, sc.priorityConcurrencyLimitReached())
+
+	sc.state.handleResult(result{job: j})
+	assert.Zero(t, sc.state.inPriority)
+	assert.False(t, sc.priorityConcurrencyLimitReached())
+}
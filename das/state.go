@@ -2,6 +2,8 @@ package das
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -14,6 +16,8 @@ type coordinatorState struct {
 	sampleFrom uint64
 	// samplingRange is the maximum amount of headers processed in one job.
 	samplingRange uint64
+	// samplingWindow is the amount of most recent headers to sample, 0 means no limit
+	samplingWindow uint64
 
 	// keeps track of running workers
 	inProgress map[int]func() workerState
@@ -22,14 +26,33 @@ type coordinatorState struct {
 	retryStrategy retryStrategy
 	// stores heights of failed headers with amount of retry attempt as value
 	failed map[uint64]retryAttempt
+	// givenUp stores heights of failed headers that exhausted all the retry attempts
+	givenUp map[uint64]GivenUpHeight
 	// inRetry stores (height -> attempt count) of failed headers that are currently being retried by
 	// workers
 	inRetry map[uint64]retryAttempt
 
+	// resample keeps ranges of heights requested for re-sampling
+	resample []HeightRange
+	// resampleBelow is the height below which headers were sampled before the checkpoint was reset
+	// and have to be sampled again
+	resampleBelow uint64
+	// paused stops scheduling of new jobs
+	paused bool
+
+	// priority queues recent headers for the priority workers
+	priority priorityQueue
+	// inPriority is the amount of running priority workers
+	inPriority int
+
 	// nextJobID is a unique identifier that will be used for creation of next job
 	nextJobID int
 	// all headers before next were sent to workers
 	next uint64
+	// backHead is the height backwards sampling was started from, 0 if sampling goes forward
+	backHead uint64
+	// all headers after backNext up to backHead were sent to workers
+	backNext uint64
 	// networkHead is the height of the latest known network head
 	networkHead uint64
 
@@ -45,20 +68,25 @@ type retryAttempt struct {
 	count int
 	// after specifies the time for the next retry attempt.
 	after time.Time
+	// unavailable indicates that every attempt so far confirmed the data to be unavailable.
+	unavailable bool
 }
 
 // newCoordinatorState initiates state for samplingCoordinator
 func newCoordinatorState(params Parameters) coordinatorState {
 	return coordinatorState{
-		sampleFrom:    params.SampleFrom,
-		samplingRange: params.SamplingRange,
-		inProgress:    make(map[int]func() workerState),
+		sampleFrom:     params.SampleFrom,
+		samplingRange:  params.SamplingRange,
+		samplingWindow: params.SamplingWindow,
+		inProgress:     make(map[int]func() workerState),
 		retryStrategy: newRetryStrategy(exponentialBackoff(
 			defaultBackoffInitialInterval,
 			defaultBackoffMultiplier,
 			defaultBackoffMaxRetryCount)),
 		failed:        make(map[uint64]retryAttempt),
+		givenUp:       make(map[uint64]GivenUpHeight),
 		inRetry:       make(map[uint64]retryAttempt),
+		priority:      newPriorityQueue(params.PriorityQueueSize),
 		nextJobID:     0,
 		next:          params.SampleFrom,
 		networkHead:   params.SampleFrom,
@@ -69,16 +97,43 @@ func newCoordinatorState(params Parameters) coordinatorState {
 func (s *coordinatorState) resumeFromCheckpoint(c checkpoint) {
 	s.next = c.SampleFrom
 	s.networkHead = c.NetworkHead
+	s.resample = c.Resample
+	s.resampleBelow = c.ResampleBelow
+	s.paused = c.Paused
 
+	unavailable := make(map[uint64]bool, len(c.Unavailable))
+	for _, h := range c.Unavailable {
+		unavailable[h] = true
+	}
 	for h, count := range c.Failed {
 		s.failed[h] = retryAttempt{
-			count: count,
+			count:       count,
+			unavailable: unavailable[h],
 		}
 	}
+
+	for h, gu := range c.GivenUp {
+		s.givenUp[h] = gu
+	}
+
+	s.backHead, s.backNext = c.BackwardHead, c.BackwardNext
 }
 
-func (s *coordinatorState) handleResult(res result) {
+// startBackwards starts sampling from the network head backwards.
+func (s *coordinatorState) startBackwards() {
+	s.backHead, s.backNext = s.networkHead, s.networkHead
+	if s.next <= s.networkHead {
+		s.next = s.networkHead + 1
+	}
+}
+
+// handleResult applies the result of the job to the state. It returns the heights given up with
+// this result.
+func (s *coordinatorState) handleResult(res result) (givenUp []GivenUpHeight) {
 	delete(s.inProgress, res.id)
+	if res.priority {
+		s.inPriority--
+	}
 
 	// check if the worker retried any of the previously failed heights
 	for h := range s.failed {
@@ -104,14 +159,26 @@ func (s *coordinatorState) handleResult(res result) {
 		}
 
 		nextRetry, retryExceeded := s.retryStrategy.nextRetry(lastRetry, time.Now())
+		// the data is only confirmed to be unavailable if every attempt has confirmed it
+		nextRetry.unavailable = res.unavailable[h] && (lastRetry.count == 0 || lastRetry.unavailable)
 		if retryExceeded {
-			log.Warnw("header exceeded maximum amount of sampling attempts",
+			log.Warnw("header exceeded maximum amount of sampling attempts, giving up",
 				"height", h,
 				"attempts", nextRetry.count)
+			gu := GivenUpHeight{
+				Height:      h,
+				Attempts:    nextRetry.count,
+				Unavailable: nextRetry.unavailable,
+				TimedOut:    res.timedOut[h],
+			}
+			s.givenUp[h] = gu
+			givenUp = append(givenUp, gu)
+			continue
 		}
 		s.failed[h] = nextRetry
 	}
 	s.checkDone()
+	return givenUp
 }
 
 func (s *coordinatorState) isNewHead(newHead int64) bool {
@@ -150,19 +217,46 @@ func (s *coordinatorState) recentJob(header *header.ExtendedHeader) job {
 	}
 }
 
-// nextJob will return next catchup or retry job according to priority (retry -> catchup)
+// priorityJob creates a job to process the most recent header queued for the priority workers.
+// It also returns the time the header spent in the queue.
+func (s *coordinatorState) priorityJob() (next job, waited time.Duration, found bool) {
+	item, found := s.priority.pop()
+	if !found {
+		return job{}, 0, false
+	}
+
+	j := s.recentJob(item.header)
+	j.priority = true
+	s.inPriority++
+	return j, time.Since(item.queuedAt), true
+}
+
+// nextJob will return next catchup, backward, resample or retry job according to priority
+// (retry -> resample -> catchup -> backward)
 func (s *coordinatorState) nextJob() (next job, found bool) {
 	// check for if any retry jobs are available
 	if job, found := s.retryJob(); found {
 		return job, found
 	}
 
+	if job, found := s.resampleJob(); found {
+		return job, found
+	}
+
 	// if no retry jobs, make a catchup job
-	return s.catchupJob()
+	if job, found := s.catchupJob(); found {
+		return job, found
+	}
+
+	return s.backwardJob()
 }
 
 // catchupJob creates a catchup job if catchup is not finished
 func (s *coordinatorState) catchupJob() (next job, found bool) {
+	// headers outside the sampling window are skipped
+	if start := s.windowStart(); s.next < start {
+		s.next = start
+	}
 	if s.next > s.networkHead {
 		return job{}, false
 	}
@@ -176,6 +270,102 @@ func (s *coordinatorState) catchupJob() (next job, found bool) {
 	return j, true
 }
 
+// backwardJob creates a job to sample the next samplingRange of headers below the ones already
+// sent to workers, if the beginning of the sampling window is not reached yet.
+func (s *coordinatorState) backwardJob() (next job, found bool) {
+	if s.backwardDone() {
+		return job{}, false
+	}
+
+	from := s.windowStart()
+	if s.backNext-from >= s.samplingRange {
+		from = s.backNext - s.samplingRange + 1
+	}
+	j := s.newJob(backwardJob, from, s.backNext)
+	s.backNext = from - 1
+	return j, true
+}
+
+// backwardDone returns true if all the headers from backHead down to the beginning of the sampling
+// window were sent to workers.
+func (s *coordinatorState) backwardDone() bool {
+	return s.backHead == 0 || s.backNext < s.windowStart()
+}
+
+// windowStart returns the lowest height to be sampled.
+func (s *coordinatorState) windowStart() uint64 {
+	if s.samplingWindow == 0 || s.networkHead < s.samplingWindow {
+		return s.sampleFrom
+	}
+	if start := s.networkHead - s.samplingWindow + 1; start > s.sampleFrom {
+		return start
+	}
+	return s.sampleFrom
+}
+
+// resampleJob creates a job to re-sample the next samplingRange of heights requested for
+// re-sampling
+func (s *coordinatorState) resampleJob() (next job, found bool) {
+	if len(s.resample) == 0 {
+		return job{}, false
+	}
+
+	from, to := s.resample[0].From, s.resample[0].From+s.samplingRange-1
+	if to >= s.resample[0].To {
+		to = s.resample[0].To
+		s.resample = s.resample[1:]
+	} else {
+		s.resample[0].From = to + 1
+	}
+	return s.newJob(resampleJob, from, to), true
+}
+
+// requestResample schedules re-sampling of the given range of heights.
+func (s *coordinatorState) requestResample(from, to uint64) error {
+	if from == 0 || from > to {
+		return fmt.Errorf("das: invalid range [%d:%d]", from, to)
+	}
+	if to > s.networkHead {
+		return fmt.Errorf("das: range end %d is above the network head %d", to, s.networkHead)
+	}
+
+	// given up heights are sampled again
+	for h := range s.givenUp {
+		if h >= from && h <= to {
+			delete(s.givenUp, h)
+		}
+	}
+
+	s.resample = append(s.resample, HeightRange{From: from, To: to})
+	s.checkDone()
+	return nil
+}
+
+// reset starts sampling over from the given height, forgetting about failed and given up heights
+// and requested re-sampling. In the backwards mode, sampling starts over from the network head
+// instead. Headers sampled before are sampled again, bypassing the cache. Jobs in progress are not
+// affected.
+func (s *coordinatorState) reset(sampleFrom uint64) {
+	// headers sampled so far are sampled again instead of being served from the cache
+	sampled := s.next
+	if s.backHead > 0 {
+		sampled = s.networkHead + 1
+	}
+	if sampled > s.resampleBelow {
+		s.resampleBelow = sampled
+	}
+
+	s.next = sampleFrom
+	if s.backHead > 0 {
+		s.startBackwards()
+	}
+	s.failed = make(map[uint64]retryAttempt)
+	s.givenUp = make(map[uint64]GivenUpHeight)
+	s.inRetry = make(map[uint64]retryAttempt)
+	s.resample = nil
+	s.checkDone()
+}
+
 // retryJob creates a job to retry previously failed header
 func (s *coordinatorState) retryJob() (next job, found bool) {
 	for h, attempt := range s.failed {
@@ -201,10 +391,11 @@ func (s *coordinatorState) putInProgress(jobID int, getState func() workerState)
 func (s *coordinatorState) newJob(jobType jobType, from, to uint64) job {
 	s.nextJobID++
 	return job{
-		id:      s.nextJobID,
-		jobType: jobType,
-		from:    from,
-		to:      to,
+		id:            s.nextJobID,
+		jobType:       jobType,
+		from:          from,
+		to:            to,
+		resampleBelow: s.resampleBelow,
 	}
 }
 
@@ -213,6 +404,37 @@ func (s *coordinatorState) unsafeStats() SamplingStats {
 	workers := make([]WorkerStats, 0, len(s.inProgress))
 	lowestFailedOrInProgress := s.next
 	failed := make(map[uint64]int)
+	// unavailable marks the failed heights, whose every attempt confirmed the data to be unavailable
+	unavailable := make(map[uint64]bool)
+	markUnavailable := func(h uint64, confirmed bool) {
+		if prev, ok := unavailable[h]; ok {
+			confirmed = confirmed && prev
+		}
+		unavailable[h] = confirmed
+	}
+
+	// in the backwards mode, headers up to backHead are sampled from top to bottom, so the not yet
+	// sampled ones limit the sampled range from below
+	sampledDownTo := s.backNext + 1
+	notSampled := func(from, to uint64) {
+		if from <= s.backHead {
+			top := to
+			if top > s.backHead {
+				top = s.backHead
+			}
+			if top >= sampledDownTo {
+				sampledDownTo = top + 1
+			}
+		}
+		if to > s.backHead && from < lowestFailedOrInProgress {
+			if from <= s.backHead {
+				from = s.backHead + 1
+			}
+			if from < lowestFailedOrInProgress {
+				lowestFailedOrInProgress = from
+			}
+		}
+	}
 
 	// gather worker stats
 	for _, getStats := range s.inProgress {
@@ -231,38 +453,75 @@ func (s *coordinatorState) unsafeStats() SamplingStats {
 
 		for h := range wstats.failed {
 			failed[h]++
-			if h < lowestFailedOrInProgress {
-				lowestFailedOrInProgress = h
-			}
+			retry, inRetry := s.inRetry[h]
+			markUnavailable(h, wstats.unavailable[h] && (!inRetry || retry.unavailable))
+			notSampled(h, h)
 		}
 
-		if wstats.curr < lowestFailedOrInProgress {
-			lowestFailedOrInProgress = wstats.curr
+		if wstats.curr <= wstats.to {
+			notSampled(wstats.curr, wstats.to)
 		}
 	}
 
 	// set lowestFailedOrInProgress to minimum failed - 1
 	for h, retry := range s.failed {
 		failed[h] += retry.count
-		if h < lowestFailedOrInProgress {
-			lowestFailedOrInProgress = h
+		markUnavailable(h, retry.unavailable)
+		notSampled(h, h)
+	}
+
+	var confirmedUnavailable []uint64
+	for h, confirmed := range unavailable {
+		if confirmed {
+			confirmedUnavailable = append(confirmedUnavailable, h)
 		}
 	}
+	sort.Slice(confirmedUnavailable, func(i, j int) bool {
+		return confirmedUnavailable[i] < confirmedUnavailable[j]
+	})
+
+	// given up heights were not sampled successfully either
+	var givenUp map[uint64]GivenUpHeight
+	for h, gu := range s.givenUp {
+		if givenUp == nil {
+			givenUp = make(map[uint64]GivenUpHeight, len(s.givenUp))
+		}
+		givenUp[h] = gu
+		notSampled(h, h)
+	}
+
+	var resample []HeightRange
+	if len(s.resample) > 0 {
+		resample = append(resample, s.resample...)
+	}
 
-	return SamplingStats{
+	stats := SamplingStats{
 		SampledChainHead: lowestFailedOrInProgress - 1,
 		CatchupHead:      s.next - 1,
 		NetworkHead:      s.networkHead,
 		Failed:           failed,
+		Unavailable:      confirmedUnavailable,
+		GivenUp:          givenUp,
 		Workers:          workers,
 		Concurrency:      len(workers),
+		PriorityQueue:    s.priority.len(),
+		Resample:         resample,
+		ResampleBelow:    s.resampleBelow,
+		Paused:           s.paused,
 		CatchUpDone:      s.catchUpDone.Load(),
 		IsRunning:        len(workers) > 0 || s.catchUpDone.Load(),
 	}
+	if s.backHead > 0 {
+		stats.SampledDownTo = sampledDownTo
+		stats.BackwardHead = s.backHead
+		stats.BackwardNext = s.backNext
+	}
+	return stats
 }
 
 func (s *coordinatorState) checkDone() {
-	if len(s.inProgress) == 0 && len(s.failed) == 0 && s.next > s.networkHead {
+	if len(s.inProgress) == 0 && len(s.failed) == 0 && len(s.resample) == 0 && s.priority.len() == 0 &&
+		s.next > s.networkHead && s.backwardDone() {
 		if s.catchUpDone.CompareAndSwap(false, true) {
 			close(s.catchUpDoneCh)
 		}
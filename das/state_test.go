@@ -1,11 +1,14 @@
 package das
 
 import (
+	"context"
 	"errors"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_coordinatorStats(t *testing.T) {
@@ -93,3 +96,187 @@ func Test_coordinatorStats(t *testing.T) {
 		})
 	}
 }
+
+func Test_coordinatorState_resample(t *testing.T) {
+	params := DefaultParameters()
+	params.SamplingRange = 10
+	s := newCoordinatorState(params)
+	s.next, s.networkHead = 101, 100
+
+	assert.Error(t, s.requestResample(0, 10))
+	assert.Error(t, s.requestResample(20, 10))
+	assert.Error(t, s.requestResample(90, 110))
+	assert.NoError(t, s.requestResample(81, 95))
+
+	// resample jobs are split by sampling range
+	j, found := s.nextJob()
+	assert.True(t, found)
+	assert.Equal(t, resampleJob, j.jobType)
+	assert.EqualValues(t, 81, j.from)
+	assert.EqualValues(t, 90, j.to)
+	assert.Equal(t, []HeightRange{{From: 91, To: 95}}, s.unsafeStats().Resample)
+
+	j, found = s.nextJob()
+	assert.True(t, found)
+	assert.EqualValues(t, 91, j.from)
+	assert.EqualValues(t, 95, j.to)
+
+	_, found = s.nextJob()
+	assert.False(t, found)
+}
+
+func Test_coordinatorState_reset(t *testing.T) {
+	s := newCoordinatorState(DefaultParameters())
+	s.next, s.networkHead = 101, 100
+	s.failed[50] = retryAttempt{count: 1}
+	assert.NoError(t, s.requestResample(1, 10))
+
+	s.reset(20)
+	stats := s.unsafeStats()
+	assert.EqualValues(t, 19, stats.CatchupHead)
+	assert.Empty(t, stats.Failed)
+	assert.Empty(t, stats.Resample)
+	assert.False(t, stats.CatchUpDone)
+	// headers sampled before the reset are sampled again
+	assert.EqualValues(t, 101, stats.ResampleBelow)
+	j, found := s.nextJob()
+	assert.True(t, found)
+	assert.EqualValues(t, 101, j.resampleBelow)
+}
+
+func Test_coordinatorState_backwards(t *testing.T) {
+	params := DefaultParameters()
+	params.SamplingRange = 10
+	params.SampleBackwards = true
+	params.SamplingWindow = 25
+	s := newCoordinatorState(params)
+	cp := checkpoint{SampleFrom: 1, NetworkHead: 100}
+	cp.startBackwards()
+	s.resumeFromCheckpoint(cp)
+
+	// newer headers are sampled before going backwards
+	s.updateHead(102)
+	j, found := s.nextJob()
+	assert.True(t, found)
+	assert.Equal(t, catchupJob, j.jobType)
+	assert.EqualValues(t, 101, j.from)
+	assert.EqualValues(t, 102, j.to)
+
+	// backward jobs stop at the beginning of the sampling window
+	for _, expected := range []HeightRange{{From: 91, To: 100}, {From: 81, To: 90}, {From: 78, To: 80}} {
+		j, found = s.nextJob()
+		assert.True(t, found)
+		assert.Equal(t, backwardJob, j.jobType)
+		assert.Equal(t, expected, HeightRange{From: j.from, To: j.to})
+		w := newWorker(j, nil, nil, nil, nil)
+		s.putInProgress(j.id, w.getState)
+	}
+	_, found = s.nextJob()
+	assert.False(t, found)
+
+	// only the range above the unfinished backward jobs is reported as sampled
+	s.handleResult(result{job: job{id: 2, jobType: backwardJob, from: 91, to: 100}})
+	s.handleResult(result{job: job{id: 4, jobType: backwardJob, from: 78, to: 80}})
+	stats := s.unsafeStats()
+	assert.EqualValues(t, 91, stats.SampledDownTo)
+	assert.EqualValues(t, 100, stats.BackwardHead)
+	assert.EqualValues(t, 77, stats.BackwardNext)
+	assert.False(t, s.catchUpDone.Load())
+
+	cp = newCheckpoint(stats)
+	assert.EqualValues(t, 100, cp.BackwardHead)
+	assert.EqualValues(t, 77, cp.BackwardNext)
+	assert.EqualValues(t, 103, cp.SampleFrom)
+}
+
+func Test_coordinatorState_givenUp(t *testing.T) {
+	s := newCoordinatorState(DefaultParameters())
+	s.retryStrategy = newRetryStrategy([]time.Duration{0})
+	s.next, s.networkHead = 11, 10
+
+	res := result{
+		job:         job{jobType: catchupJob, from: 1, to: 10},
+		failed:      map[uint64]int{5: 1},
+		unavailable: map[uint64]bool{5: true},
+	}
+	assert.Empty(t, s.handleResult(res))
+	assert.Contains(t, s.failed, uint64(5))
+
+	// retry fails once again and exhausts the attempts
+	j, found := s.retryJob()
+	assert.True(t, found)
+	res.job = j
+	givenUp := s.handleResult(res)
+	assert.Equal(t, []GivenUpHeight{{Height: 5, Attempts: 2, Unavailable: true}}, givenUp)
+	assert.NotContains(t, s.failed, uint64(5))
+
+	// given up heights are not retried
+	_, found = s.retryJob()
+	assert.False(t, found)
+	stats := s.unsafeStats()
+	assert.Contains(t, stats.GivenUp, uint64(5))
+	assert.EqualValues(t, 4, stats.SampledChainHead)
+	assert.True(t, stats.CatchUpDone)
+
+	// re-sampling brings the height back
+	assert.NoError(t, s.requestResample(5, 5))
+	assert.Empty(t, s.unsafeStats().GivenUp)
+}
+
+func Test_coordinatorState_givenUpUnavailable(t *testing.T) {
+	newState := func() *coordinatorState {
+		s := newCoordinatorState(DefaultParameters())
+		s.retryStrategy = newRetryStrategy([]time.Duration{0})
+		s.next, s.networkHead = 11, 10
+		return &s
+	}
+	unavailable := result{
+		job:         job{jobType: catchupJob, from: 1, to: 10},
+		failed:      map[uint64]int{5: 1},
+		unavailable: map[uint64]bool{5: true},
+	}
+	timedOut := result{
+		job:      job{jobType: catchupJob, from: 1, to: 10},
+		failed:   map[uint64]int{5: 1},
+		timedOut: map[uint64]bool{5: true},
+	}
+
+	// a timeout on any of the attempts leaves the unavailability unconfirmed
+	s := newState()
+	assert.Empty(t, s.handleResult(timedOut))
+	j, found := s.retryJob()
+	require.True(t, found)
+	unavailable.job = j
+	givenUp := s.handleResult(unavailable)
+	assert.Equal(t, []GivenUpHeight{{Height: 5, Attempts: 2}}, givenUp)
+
+	// the unavailability confirmed by the previous attempts survives restarts
+	s = newState()
+	unavailable.job = job{jobType: catchupJob, from: 1, to: 10}
+	assert.Empty(t, s.handleResult(unavailable))
+	stats := s.unsafeStats()
+	assert.Equal(t, []uint64{5}, stats.Unavailable)
+
+	s = newState()
+	s.resumeFromCheckpoint(newCheckpoint(stats))
+	j, found = s.retryJob()
+	require.True(t, found)
+	unavailable.job = j
+	givenUp = s.handleResult(unavailable)
+	assert.Equal(t, []GivenUpHeight{{Height: 5, Attempts: 2, Unavailable: true}}, givenUp)
+}
+
+func Test_givenUpSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	subs := newGivenUpSubscriptions()
+	ch := subs.subscribe(ctx)
+
+	subs.publish(GivenUpHeight{Height: 1})
+	assert.Equal(t, GivenUpHeight{Height: 1}, <-ch)
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+	// publishing after the subscriber is gone must not panic
+	subs.publish(GivenUpHeight{Height: 2})
+}
@@ -2,25 +2,54 @@ package das
 
 // SamplingStats collects information about the DASer process.
 type SamplingStats struct {
-	// all headers before SampledChainHead were successfully sampled
+	// all headers before SampledChainHead were successfully sampled. In the backwards mode, only the
+	// headers starting from SampledDownTo are.
 	SampledChainHead uint64 `json:"head_of_sampled_chain"`
+	// SampledDownTo is the lowest height of the successfully sampled range ending at
+	// SampledChainHead, when sampling goes backwards
+	SampledDownTo uint64 `json:"sampled_down_to,omitempty"`
 	// all headers before CatchupHead were submitted to sampling workers. They could be either already
 	// sampled, failed or still in progress. For in progress items check Workers stat.
 	CatchupHead uint64 `json:"head_of_catchup"`
 	// NetworkHead is the height of the most recent header in the network
 	NetworkHead uint64 `json:"network_head_height"`
+	// BackwardHead is the height sampling backwards was started from, 0 if sampling goes forward
+	BackwardHead uint64 `json:"backward_head,omitempty"`
+	// all headers after BackwardNext up to BackwardHead were submitted to sampling workers
+	BackwardNext uint64 `json:"backward_next,omitempty"`
 	// Failed contains all skipped headers heights with corresponding try count
 	Failed map[uint64]int `json:"failed,omitempty"`
+	// Unavailable lists the Failed heights, which data every sampling attempt so far confirmed to be
+	// unavailable
+	Unavailable []uint64 `json:"unavailable,omitempty"`
+	// GivenUp contains headers heights, that exhausted all the sampling attempts and are not retried
+	GivenUp map[uint64]GivenUpHeight `json:"given_up,omitempty"`
 	// Workers has information about each currently running worker stats
 	Workers []WorkerStats `json:"workers,omitempty"`
 	// Concurrency amount of currently running parallel workers
 	Concurrency int `json:"concurrency"`
+	// PriorityQueue is the amount of recent headers waiting for the priority workers
+	PriorityQueue int `json:"priority_queue"`
+	// Resample contains ranges of heights requested for re-sampling, which were not yet submitted to
+	// workers
+	Resample []HeightRange `json:"resample,omitempty"`
+	// all headers before ResampleBelow are sampled again, as they were sampled before the checkpoint
+	// was reset
+	ResampleBelow uint64 `json:"resample_below,omitempty"`
+	// Paused indicates whether scheduling of new sampling jobs is paused
+	Paused bool `json:"paused"`
 	// CatchUpDone indicates whether all known headers are sampled
 	CatchUpDone bool `json:"catch_up_done"`
 	// IsRunning tracks whether the DASer service is running
 	IsRunning bool `json:"is_running"`
 }
 
+// HeightRange is an inclusive range of heights.
+type HeightRange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
 type WorkerStats struct {
 	JobType jobType `json:"job_type"`
 	Curr    uint64  `json:"current"`
@@ -36,7 +65,12 @@ func (s SamplingStats) totalSampled() uint64 {
 	for _, w := range s.Workers {
 		inProgress += w.To - w.Curr + 1
 	}
-	return s.CatchupHead - inProgress - uint64(len(s.Failed))
+	submitted := s.CatchupHead
+	if s.BackwardHead > 0 {
+		// headers below BackwardNext were not submitted yet
+		submitted -= s.BackwardNext
+	}
+	return submitted - inProgress - uint64(len(s.Failed)) - uint64(len(s.GivenUp))
 }
 
 // workersByJobType returns a map of job types to the number of workers assigned to those types.
@@ -77,7 +77,7 @@ func (s *checkpointStore) runBackgroundStore(
 	ticker := time.NewTicker(storeInterval)
 	defer ticker.Stop()
 
-	var prev uint64
+	var prev, prevBackward uint64
 	for {
 		// blocked by ticker to perform storing only once in a period
 		select {
@@ -91,11 +91,12 @@ func (s *checkpointStore) runBackgroundStore(
 			log.Debug("DASer coordinator checkpoint is unavailable")
 			continue
 		}
-		if cp.SampleFrom > prev {
+		// SampleFrom may also go back once the checkpoint is reset
+		if cp.SampleFrom != prev || cp.BackwardNext != prevBackward {
 			if err = s.store(ctx, cp); err != nil {
 				log.Errorw("storing checkpoint to disk", "err", err)
 			}
-			prev = cp.SampleFrom
+			prev, prevBackward = cp.SampleFrom, cp.BackwardNext
 		}
 	}
 }
@@ -10,6 +10,8 @@ import (
 	libhead "github.com/celestiaorg/go-header"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
@@ -17,6 +19,10 @@ const (
 	catchupJob jobType = "catchup"
 	recentJob  jobType = "recent"
 	retryJob   jobType = "retry"
+	// resampleJob samples headers again, even if they were successfully sampled before
+	resampleJob jobType = "resample"
+	// backwardJob samples headers older than the ones sampled before
+	backwardJob jobType = "backward"
 )
 
 type worker struct {
@@ -48,6 +54,11 @@ type job struct {
 
 	// header is set only for recentJobs, avoiding an unnecessary call to the header store
 	header *header.ExtendedHeader
+	// priority is set for recentJobs run by the priority workers
+	priority bool
+	// resampleBelow is the height below which headers are sampled again, even if they were
+	// successfully sampled before
+	resampleBelow uint64
 }
 
 func newWorker(j job,
@@ -64,8 +75,10 @@ func newWorker(j job,
 		state: workerState{
 			curr: j.from,
 			result: result{
-				job:    j,
-				failed: make(map[uint64]int),
+				job:         j,
+				failed:      make(map[uint64]int),
+				unavailable: make(map[uint64]bool),
+				timedOut:    make(map[uint64]bool),
 			},
 		},
 	}
@@ -108,6 +121,9 @@ func (w *worker) sample(ctx context.Context, timeout time.Duration, height uint6
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
+	if w.state.jobType == resampleJob || height < w.state.resampleBelow {
+		ctx = cache.WithResample(ctx)
+	}
 
 	err = w.sampleFn(ctx, h)
 	w.metrics.observeSample(ctx, h, time.Since(start), w.state.jobType, err)
@@ -183,6 +199,11 @@ func (w *worker) setResult(curr uint64, err error) {
 	defer w.lock.Unlock()
 	if err != nil {
 		w.state.failed[curr]++
+		// timeouts are caused by slow or missing peers as well, so they do not confirm the data to be
+		// unavailable
+		timedOut := errors.Is(err, context.DeadlineExceeded)
+		w.state.timedOut[curr] = timedOut
+		w.state.unavailable[curr] = !timedOut && errors.Is(err, share.ErrNotAvailable)
 		w.state.err = errors.Join(w.state.err, fmt.Errorf("height: %d, err: %w", curr, err))
 	}
 	w.state.curr = curr
@@ -0,0 +1,354 @@
+package pruner
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	logging "github.com/ipfs/go-log/v2"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+var log = logging.Logger("header/pruner")
+
+var (
+	// storePrefix and headKey mirror the keys of the header store
+	storePrefix   = datastore.NewKey("headers")
+	headKey       = datastore.NewKey("head")
+	prunerPrefix  = datastore.NewKey("header_pruner")
+	lastPrunedKey = datastore.NewKey("last_pruned")
+
+	defaultBatchSize = uint64(100)
+)
+
+// Parameters is the set of parameters that configure the Pruner.
+type Parameters struct {
+	// KeepHeights is the amount of the most recent headers kept in the header store. The oldest of
+	// them remains as the trusted tail of the store. Zero disables pruning.
+	KeepHeights uint64
+	// PruneInterval is the interval at which the Pruner removes the headers that fell out of the
+	// retention window.
+	PruneInterval time.Duration
+}
+
+// DefaultParameters returns the default configuration values for the Pruner. Pruning is disabled by
+// default.
+func DefaultParameters() Parameters {
+	return Parameters{
+		PruneInterval: time.Minute * 5,
+	}
+}
+
+// Validate validates the values in Parameters.
+func (p *Parameters) Validate() error {
+	if p.Enabled() && p.PruneInterval <= 0 {
+		return fmt.Errorf("header/pruner: prune interval must be positive")
+	}
+	return nil
+}
+
+// Enabled reports whether the retention window is set.
+func (p *Parameters) Enabled() bool {
+	return p.KeepHeights > 0
+}
+
+// PruneFn is called for every header removed from the header store, so that the data associated
+// with the header can be removed along with it.
+type PruneFn func(context.Context, *header.ExtendedHeader) error
+
+// Pruner periodically removes the headers that fell out of the retention window from the header
+// store. The headers are read and removed directly from the datastore the header store is kept in,
+// as the store does not support removals. The last pruned height is persisted, so that pruning
+// resumes where it stopped after restarts.
+//
+// NOTE: As the removals bypass the header store, the running store keeps serving the pruned headers
+// it has cached until they are evicted. Only the headers requested from the store after they fell
+// out of the retention window may be cached, as the Pruner does not read through the store.
+type Pruner struct {
+	params Parameters
+
+	head libhead.Head[*header.ExtendedHeader]
+	// ds is the datastore the header store is kept in. The removals are batched together with the
+	// last pruned height, so that they never get out of sync.
+	ds      datastore.Batching
+	store   datastore.Datastore
+	pruner  datastore.Datastore
+	pruneFn []PruneFn
+
+	lastPruned atomic.Uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPruner creates a new Pruner removing the headers of the header store kept in the given
+// datastore. The head of the header store defines the retention window.
+func NewPruner(
+	params Parameters,
+	head libhead.Head[*header.ExtendedHeader],
+	ds datastore.Batching,
+	pruneFn ...PruneFn,
+) (*Pruner, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Pruner{
+		params:  params,
+		head:    head,
+		ds:      ds,
+		store:   namespace.Wrap(ds, storePrefix),
+		pruner:  namespace.Wrap(ds, prunerPrefix),
+		pruneFn: pruneFn,
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// Start loads the last pruned height and starts the pruning routine, if pruning is enabled.
+func (p *Pruner) Start(ctx context.Context) error {
+	if !p.params.Enabled() {
+		close(p.done)
+		return nil
+	}
+
+	if err := p.loadLastPruned(ctx); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	go p.run(ctx)
+	return nil
+}
+
+// Stop stops the pruning routine.
+func (p *Pruner) Stop(ctx context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// LastPruned returns the last height whose header has been pruned.
+func (p *Pruner) LastPruned() uint64 {
+	return p.lastPruned.Load()
+}
+
+// Prune removes the headers that fell out of the retention window once. It is meant to be used
+// with the stopped node, e.g. for offline compaction.
+func (p *Pruner) Prune(ctx context.Context) error {
+	if !p.params.Enabled() {
+		return fmt.Errorf("header/pruner: pruning is disabled")
+	}
+	if err := p.loadLastPruned(ctx); err != nil {
+		return err
+	}
+	return p.prune(ctx)
+}
+
+func (p *Pruner) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.params.PruneInterval)
+	defer ticker.Stop()
+	for {
+		err := p.prune(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorw("pruning headers", "last_pruned", p.LastPruned(), "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prune removes all the headers below the retention window, keeping the lowest header within the
+// window as the tail of the store.
+func (p *Pruner) prune(ctx context.Context) error {
+	head, err := p.head.Head(ctx)
+	if err != nil {
+		return fmt.Errorf("getting head: %w", err)
+	}
+	if uint64(head.Height()) <= p.params.KeepHeights {
+		return nil
+	}
+	tail := uint64(head.Height()) - p.params.KeepHeights + 1
+
+	from := p.LastPruned()
+	if from == 0 {
+		// the header store may be synced from a trusted header far above the first height, so the
+		// heights preceding the lowest stored one are skipped instead of being looked up one by one
+		lowest, found, err := p.lowestStored(ctx, tail)
+		if err != nil || !found {
+			return err
+		}
+		from = lowest - 1
+	}
+
+	to := from
+	for to+1 < tail {
+		batchTo := to + defaultBatchSize
+		if batchTo >= tail {
+			batchTo = tail - 1
+		}
+		pruned, err := p.pruneBatch(ctx, to+1, batchTo)
+		if err != nil {
+			return err
+		}
+		if pruned == to {
+			break
+		}
+		to = pruned
+	}
+	if to > from {
+		log.Debugw("pruned headers", "from", from+1, "to", to)
+	}
+	return nil
+}
+
+// pruneBatch removes the headers within the given inclusive range of heights along with storing the
+// last pruned height in a single batch. It returns the last pruned height, which is lower than the
+// end of the range, if the header store has not yet written the headers above it to disk.
+func (p *Pruner) pruneBatch(ctx context.Context, from, to uint64) (uint64, error) {
+	batch, err := p.ds.Batch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastPruned := from - 1
+	for height := from; height <= to; height++ {
+		h, err := p.getHeader(ctx, height)
+		if errors.Is(err, datastore.ErrNotFound) {
+			// the header store writes the headers to disk in batches
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("getting header at height %d: %w", height, err)
+		}
+		if err = p.removeHeader(ctx, batch, h); err != nil {
+			return 0, err
+		}
+		lastPruned = height
+	}
+	if lastPruned < from {
+		return lastPruned, nil
+	}
+
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, lastPruned)
+	if err = batch.Put(ctx, prunerPrefix.Child(lastPrunedKey), bs); err != nil {
+		return 0, err
+	}
+	if err = batch.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("removing headers: %w", err)
+	}
+	p.lastPruned.Store(lastPruned)
+	return lastPruned, nil
+}
+
+// lowestStored returns the lowest height stored in the header store below the given one, if any.
+// The header store writes the headers to disk contiguously from the height it was initialized with
+// up to the head written last, so the lowest one is found with a binary search.
+func (p *Pruner) lowestStored(ctx context.Context, below uint64) (uint64, bool, error) {
+	b, err := p.store.Get(ctx, headKey)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("getting the head written to disk: %w", err)
+	}
+	var hash libhead.Hash
+	if err = hash.UnmarshalJSON(b); err != nil {
+		return 0, false, fmt.Errorf("unmarshalling the head written to disk: %w", err)
+	}
+	head, err := p.getHeaderByHash(ctx, hash)
+	if err != nil {
+		return 0, false, fmt.Errorf("getting the head written to disk: %w", err)
+	}
+
+	n := int(below - 1)
+	if top := int(head.Height()); top < n {
+		n = top
+	}
+	var searchErr error
+	lowest := sort.Search(n, func(i int) bool {
+		has, err := p.store.Has(ctx, heightKey(uint64(i+1)))
+		if err != nil && searchErr == nil {
+			searchErr = err
+		}
+		return has
+	})
+	if searchErr != nil {
+		return 0, false, fmt.Errorf("searching the lowest stored header: %w", searchErr)
+	}
+	return uint64(lowest + 1), lowest < n, nil
+}
+
+// getHeader reads the header at the given height from the datastore, without populating the caches
+// of the header store.
+func (p *Pruner) getHeader(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
+	hash, err := p.store.Get(ctx, heightKey(height))
+	if err != nil {
+		return nil, err
+	}
+	return p.getHeaderByHash(ctx, hash)
+}
+
+func (p *Pruner) getHeaderByHash(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error) {
+	data, err := p.store.Get(ctx, datastore.NewKey(hash.String()))
+	if err != nil {
+		return nil, err
+	}
+	h := &header.ExtendedHeader{}
+	return h, h.UnmarshalBinary(data)
+}
+
+// removeHeader removes the header along with its height index and the data associated with it.
+func (p *Pruner) removeHeader(ctx context.Context, batch datastore.Batch, h *header.ExtendedHeader) error {
+	for _, fn := range p.pruneFn {
+		if err := fn(ctx, h); err != nil {
+			return fmt.Errorf("pruning header at height %d: %w", h.Height(), err)
+		}
+	}
+
+	err := batch.Delete(ctx, storePrefix.Child(heightKey(uint64(h.Height()))))
+	if err != nil {
+		return err
+	}
+	return batch.Delete(ctx, storePrefix.Child(datastore.NewKey(h.Hash().String())))
+}
+
+// heightKey mirrors the key of the height index of the header store.
+func heightKey(height uint64) datastore.Key {
+	return datastore.NewKey(strconv.FormatUint(height, 10))
+}
+
+func (p *Pruner) loadLastPruned(ctx context.Context) error {
+	bs, err := p.pruner.Get(ctx, lastPrunedKey)
+	switch {
+	case err == nil:
+		p.lastPruned.Store(binary.BigEndian.Uint64(bs))
+	case !errors.Is(err, datastore.ErrNotFound):
+		return fmt.Errorf("header/pruner: loading last pruned height: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package pruner
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/go-header/store"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+)
+
+func TestPruner(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	// headers 1-10 are written to disk
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	suite := headertest.NewTestSuite(t, 3)
+	hstore, err := store.NewStoreWithHead(ctx, ds, suite.Head())
+	require.NoError(t, err)
+	err = hstore.Start(ctx)
+	require.NoError(t, err)
+	headers := append([]*header.ExtendedHeader{suite.Head()}, suite.GenExtendedHeaders(9)...)
+	err = hstore.Append(ctx, headers[1:]...)
+	require.NoError(t, err)
+	err = hstore.Stop(ctx)
+	require.NoError(t, err)
+
+	hstore, err = store.NewStore[*header.ExtendedHeader](ds)
+	require.NoError(t, err)
+	_, err = hstore.Head(ctx)
+	require.NoError(t, err)
+
+	var pruned []uint64
+	params := DefaultParameters()
+	params.KeepHeights = 6
+	pruner, err := NewPruner(params, hstore, ds, func(_ context.Context, h *header.ExtendedHeader) error {
+		pruned = append(pruned, uint64(h.Height()))
+		return nil
+	})
+	require.NoError(t, err)
+
+	// headers 1-4 are out of the window, while header 5 remains as the tail
+	err = pruner.Prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruner.LastPruned())
+	require.Equal(t, []uint64{1, 2, 3, 4}, pruned)
+	for _, h := range headers {
+		has, err := ds.Has(ctx, storePrefix.ChildString(h.Hash().String()))
+		require.NoError(t, err)
+		require.Equal(t, h.Height() > 4, has, "height %d", h.Height())
+		has, err = ds.Has(ctx, storePrefix.ChildString(strconv.FormatInt(h.Height(), 10)))
+		require.NoError(t, err)
+		require.Equal(t, h.Height() > 4, has, "height %d", h.Height())
+	}
+
+	// the last pruned height is restored after restart
+	pruner, err = NewPruner(params, hstore, ds)
+	require.NoError(t, err)
+	err = pruner.Start(ctx)
+	require.NoError(t, err)
+	err = pruner.Stop(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruner.LastPruned())
+}
+
+// TestPruner_TrustedTail tests that pruning of the header store synced from a trusted header starts
+// from the lowest stored height.
+func TestPruner_TrustedTail(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	// headers 1-20 are written to disk
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	suite := headertest.NewTestSuite(t, 3)
+	hstore, err := store.NewStoreWithHead(ctx, ds, suite.Head())
+	require.NoError(t, err)
+	err = hstore.Start(ctx)
+	require.NoError(t, err)
+	headers := append([]*header.ExtendedHeader{suite.Head()}, suite.GenExtendedHeaders(19)...)
+	err = hstore.Append(ctx, headers[1:]...)
+	require.NoError(t, err)
+	err = hstore.Stop(ctx)
+	require.NoError(t, err)
+	// while the store synced from the trusted header at height 10 has no headers below it
+	for _, h := range headers[:9] {
+		err = ds.Delete(ctx, storePrefix.ChildString(h.Hash().String()))
+		require.NoError(t, err)
+		err = ds.Delete(ctx, storePrefix.ChildString(strconv.FormatInt(h.Height(), 10)))
+		require.NoError(t, err)
+	}
+
+	hstore, err = store.NewStore[*header.ExtendedHeader](ds)
+	require.NoError(t, err)
+	_, err = hstore.Head(ctx)
+	require.NoError(t, err)
+
+	var pruned []uint64
+	params := DefaultParameters()
+	params.KeepHeights = 6
+	pruner, err := NewPruner(params, hstore, ds, func(_ context.Context, h *header.ExtendedHeader) error {
+		pruned = append(pruned, uint64(h.Height()))
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = pruner.Prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 14, pruner.LastPruned())
+	require.Equal(t, []uint64{10, 11, 12, 13, 14}, pruned)
+}
+
+// TestPruner_RunningStore tests pruning of the running header store. The headers not yet written to
+// disk by the store are pruned once they are written, while the pruned headers are only served by
+// the store if they were cached before.
+func TestPruner_RunningStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	// only the first header is written to disk
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	suite := headertest.NewTestSuite(t, 3)
+	hstore, err := store.NewStoreWithHead(ctx, ds, suite.Head())
+	require.NoError(t, err)
+	err = hstore.Start(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, hstore.Stop(ctx))
+	})
+	err = hstore.Append(ctx, suite.GenExtendedHeaders(9)...)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return hstore.Height() == 10
+	}, time.Second, time.Millisecond*10)
+
+	params := DefaultParameters()
+	params.KeepHeights = 6
+	pruner, err := NewPruner(params, hstore, ds)
+	require.NoError(t, err)
+
+	err = pruner.Prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruner.LastPruned())
+
+	// headers 2-4 are pruned once written to disk
+	err = hstore.Stop(ctx)
+	require.NoError(t, err)
+	hstore, err = store.NewStore[*header.ExtendedHeader](ds)
+	require.NoError(t, err)
+	err = hstore.Start(ctx)
+	require.NoError(t, err)
+	_, err = hstore.Head(ctx)
+	require.NoError(t, err)
+	_, err = hstore.GetByHeight(ctx, 3)
+	require.NoError(t, err)
+
+	pruner, err = NewPruner(params, hstore, ds)
+	require.NoError(t, err)
+	err = pruner.Prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruner.LastPruned())
+
+	_, err = hstore.GetByHeight(ctx, 2)
+	require.ErrorIs(t, err, libhead.ErrNotFound)
+	// the store keeps serving the header from its cache until it is evicted
+	_, err = hstore.GetByHeight(ctx, 3)
+	require.NoError(t, err)
+	_, err = hstore.GetByHeight(ctx, 5)
+	require.NoError(t, err)
+}
@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/header"
+	headerService "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+func ConstructModule(tp node.Type) fx.Option {
+	baseComponents := fx.Options(
+		fx.Provide(
+			func(service headerService.Module) func(context.Context, uint64) (*header.ExtendedHeader, error) {
+				return service.GetByHeight
+			},
+		),
+		fx.Provide(func(
+			state *state.CoreAccessor,
+			sGetter share.Getter,
+			getByHeightFn func(context.Context, uint64) (*header.ExtendedHeader, error),
+		) Module {
+			return blob.NewService(state, sGetter, getByHeightFn)
+		}),
+	)
+
+	switch tp {
+	case node.Light, node.Full, node.Bridge:
+		return fx.Module(
+			"blob",
+			baseComponents,
+		)
+	default:
+		panic("invalid node type")
+	}
+}
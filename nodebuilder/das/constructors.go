@@ -13,6 +13,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
@@ -33,6 +34,34 @@ func (d daserStub) WaitCatchUp(context.Context) error {
 	return errStub
 }
 
+func (d daserStub) SampledRange(context.Context, uint64, uint64) ([]*cache.SamplingRecord, error) {
+	return nil, errStub
+}
+
+func (d daserStub) SamplingResult(context.Context, uint64) (*share.SamplingResult, error) {
+	return nil, errStub
+}
+
+func (d daserStub) SubscribeGivenUp(context.Context) (<-chan das.GivenUpHeight, error) {
+	return nil, errStub
+}
+
+func (d daserStub) Pause(context.Context) error {
+	return errStub
+}
+
+func (d daserStub) Resume(context.Context) error {
+	return errStub
+}
+
+func (d daserStub) ResampleRange(context.Context, uint64, uint64) error {
+	return errStub
+}
+
+func (d daserStub) ResetCheckpoint(context.Context, uint64) error {
+	return errStub
+}
+
 func newDaserStub() Module {
 	return &daserStub{}
 }
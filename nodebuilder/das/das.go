@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 )
 
 var _ Module = (*API)(nil)
@@ -14,6 +16,27 @@ type Module interface {
 	SamplingStats(ctx context.Context) (das.SamplingStats, error)
 	// WaitCatchUp blocks until DASer finishes catching up to the network head.
 	WaitCatchUp(ctx context.Context) error
+	// SampledRange returns the records of successfully sampled headers within the given inclusive
+	// range of heights. Heights that were not sampled are omitted.
+	SampledRange(ctx context.Context, from, to uint64) ([]*cache.SamplingRecord, error)
+	// SamplingResult returns the outcome of every sample taken during the most recent sampling of
+	// the header at the given height. Only the results of recently sampled headers are kept.
+	SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error)
+
+	// SubscribeGivenUp subscribes for heights given up after exhausting all the sampling attempts.
+	SubscribeGivenUp(ctx context.Context) (<-chan das.GivenUpHeight, error)
+
+	// Pause stops scheduling of new sampling jobs until Resume is called. Jobs in progress are
+	// finished.
+	Pause(ctx context.Context) error
+	// Resume resumes sampling paused with Pause.
+	Resume(ctx context.Context) error
+	// ResampleRange schedules sampling of the headers within the given inclusive range of heights,
+	// even if they were successfully sampled before.
+	ResampleRange(ctx context.Context, from, to uint64) error
+	// ResetCheckpoint starts sampling over from the given height, forgetting about failed heights.
+	// Zero height resets to the configured SampleFrom.
+	ResetCheckpoint(ctx context.Context, sampleFrom uint64) error
 }
 
 // API is a wrapper around Module for the RPC.
@@ -22,6 +45,19 @@ type API struct {
 	Internal struct {
 		SamplingStats func(ctx context.Context) (das.SamplingStats, error) `perm:"read"`
 		WaitCatchUp   func(ctx context.Context) error                      `perm:"read"`
+		SampledRange  func(
+			ctx context.Context,
+			from, to uint64,
+		) ([]*cache.SamplingRecord, error) `perm:"read"`
+		SamplingResult func(
+			ctx context.Context,
+			height uint64,
+		) (*share.SamplingResult, error) `perm:"read"`
+		SubscribeGivenUp func(ctx context.Context) (<-chan das.GivenUpHeight, error) `perm:"read"`
+		Pause            func(ctx context.Context) error                             `perm:"admin"`
+		Resume           func(ctx context.Context) error                             `perm:"admin"`
+		ResampleRange    func(ctx context.Context, from, to uint64) error            `perm:"admin"`
+		ResetCheckpoint  func(ctx context.Context, sampleFrom uint64) error          `perm:"admin"`
 	}
 }
 
@@ -32,3 +68,31 @@ func (api *API) SamplingStats(ctx context.Context) (das.SamplingStats, error) {
 func (api *API) WaitCatchUp(ctx context.Context) error {
 	return api.Internal.WaitCatchUp(ctx)
 }
+
+func (api *API) SampledRange(ctx context.Context, from, to uint64) ([]*cache.SamplingRecord, error) {
+	return api.Internal.SampledRange(ctx, from, to)
+}
+
+func (api *API) SamplingResult(ctx context.Context, height uint64) (*share.SamplingResult, error) {
+	return api.Internal.SamplingResult(ctx, height)
+}
+
+func (api *API) SubscribeGivenUp(ctx context.Context) (<-chan das.GivenUpHeight, error) {
+	return api.Internal.SubscribeGivenUp(ctx)
+}
+
+func (api *API) Pause(ctx context.Context) error {
+	return api.Internal.Pause(ctx)
+}
+
+func (api *API) Resume(ctx context.Context) error {
+	return api.Internal.Resume(ctx)
+}
+
+func (api *API) ResampleRange(ctx context.Context, from, to uint64) error {
+	return api.Internal.ResampleRange(ctx, from, to)
+}
+
+func (api *API) ResetCheckpoint(ctx context.Context, sampleFrom uint64) error {
+	return api.Internal.ResetCheckpoint(ctx, sampleFrom)
+}
@@ -11,6 +11,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 
 	das "github.com/celestiaorg/celestia-node/das"
+	share "github.com/celestiaorg/celestia-node/share"
+	cache "github.com/celestiaorg/celestia-node/share/availability/cache"
 )
 
 // MockModule is a mock of Module interface.
@@ -36,6 +38,92 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// Pause mocks base method.
+func (m *MockModule) Pause(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockModuleMockRecorder) Pause(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockModule)(nil).Pause), arg0)
+}
+
+// ResampleRange mocks base method.
+func (m *MockModule) ResampleRange(arg0 context.Context, arg1, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResampleRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResampleRange indicates an expected call of ResampleRange.
+func (mr *MockModuleMockRecorder) ResampleRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResampleRange", reflect.TypeOf((*MockModule)(nil).ResampleRange), arg0, arg1, arg2)
+}
+
+// ResetCheckpoint mocks base method.
+func (m *MockModule) ResetCheckpoint(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetCheckpoint indicates an expected call of ResetCheckpoint.
+func (mr *MockModuleMockRecorder) ResetCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCheckpoint", reflect.TypeOf((*MockModule)(nil).ResetCheckpoint), arg0, arg1)
+}
+
+// Resume mocks base method.
+func (m *MockModule) Resume(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockModuleMockRecorder) Resume(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockModule)(nil).Resume), arg0)
+}
+
+// SampledRange mocks base method.
+func (m *MockModule) SampledRange(arg0 context.Context, arg1, arg2 uint64) ([]*cache.SamplingRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SampledRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*cache.SamplingRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SampledRange indicates an expected call of SampledRange.
+func (mr *MockModuleMockRecorder) SampledRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SampledRange", reflect.TypeOf((*MockModule)(nil).SampledRange), arg0, arg1, arg2)
+}
+
+// SamplingResult mocks base method.
+func (m *MockModule) SamplingResult(arg0 context.Context, arg1 uint64) (*share.SamplingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SamplingResult", arg0, arg1)
+	ret0, _ := ret[0].(*share.SamplingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SamplingResult indicates an expected call of SamplingResult.
+func (mr *MockModuleMockRecorder) SamplingResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingResult", reflect.TypeOf((*MockModule)(nil).SamplingResult), arg0, arg1)
+}
+
 // SamplingStats mocks base method.
 func (m *MockModule) SamplingStats(arg0 context.Context) (das.SamplingStats, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +139,21 @@ func (mr *MockModuleMockRecorder) SamplingStats(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SamplingStats", reflect.TypeOf((*MockModule)(nil).SamplingStats), arg0)
 }
 
+// SubscribeGivenUp mocks base method.
+func (m *MockModule) SubscribeGivenUp(arg0 context.Context) (<-chan das.GivenUpHeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeGivenUp", arg0)
+	ret0, _ := ret[0].(<-chan das.GivenUpHeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeGivenUp indicates an expected call of SubscribeGivenUp.
+func (mr *MockModuleMockRecorder) SubscribeGivenUp(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeGivenUp", reflect.TypeOf((*MockModule)(nil).SubscribeGivenUp), arg0)
+}
+
 // WaitCatchUp mocks base method.
 func (m *MockModule) WaitCatchUp(arg0 context.Context) error {
 	m.ctrl.T.Helper()
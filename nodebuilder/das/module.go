@@ -29,6 +29,11 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 					das.WithBackgroundStoreInterval(c.BackgroundStoreInterval),
 					das.WithSampleFrom(c.SampleFrom),
 					das.WithSampleTimeout(c.SampleTimeout),
+					das.WithPriorityQueueSize(c.PriorityQueueSize),
+					das.WithPriorityConcurrencyLimit(c.PriorityConcurrencyLimit),
+					das.WithHaltOnUnavailable(c.HaltOnUnavailable),
+					das.WithSampleBackwards(c.SampleBackwards),
+					das.WithSamplingWindow(c.SamplingWindow),
 				}
 			},
 		),
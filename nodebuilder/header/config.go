@@ -12,6 +12,7 @@ import (
 	"github.com/celestiaorg/go-header/store"
 	"github.com/celestiaorg/go-header/sync"
 
+	"github.com/celestiaorg/celestia-node/header/pruner"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 )
@@ -31,6 +32,10 @@ type Config struct {
 
 	Server p2p_exchange.ServerParameters
 	Client p2p_exchange.ClientParameters `toml:",omitempty"`
+
+	// Pruner sets the retention window of the header store on light nodes. The DASer's sampling
+	// window must not exceed it, as the pruned headers can't be sampled.
+	Pruner pruner.Parameters `toml:",omitempty"`
 }
 
 func DefaultConfig(tp node.Type) Config {
@@ -45,7 +50,11 @@ func DefaultConfig(tp node.Type) Config {
 	switch tp {
 	case node.Bridge:
 		return cfg
-	case node.Light, node.Full:
+	case node.Light:
+		cfg.Client = p2p_exchange.DefaultClientParameters()
+		cfg.Pruner = pruner.DefaultParameters()
+		return cfg
+	case node.Full:
 		cfg.Client = p2p_exchange.DefaultClientParameters()
 		return cfg
 	default:
@@ -102,6 +111,15 @@ func (cfg *Config) Validate(tp node.Type) error {
 		return fmt.Errorf("module/header: misconfiguration of p2p exchange server: %w", err)
 	}
 
+	// full and bridge nodes serve the headers to the network, so they keep all of them
+	if tp != node.Light && cfg.Pruner.Enabled() {
+		return fmt.Errorf("module/header: header pruning is only supported by light nodes")
+	}
+	err = cfg.Pruner.Validate()
+	if err != nil {
+		return fmt.Errorf("module/header: misconfiguration of pruner: %w", err)
+	}
+
 	// we do not create a client for bridge nodes
 	if tp == node.Bridge {
 		return nil
@@ -114,3 +132,20 @@ func (cfg *Config) Validate(tp node.Type) error {
 
 	return nil
 }
+
+// ValidateSamplingWindow ensures that the headers within the given sampling window of the DASer are
+// not pruned, as the DASer fails to sample the pruned headers and gives up on them. Zero sampling
+// window samples all the headers, so it is only valid with pruning disabled.
+func (cfg *Config) ValidateSamplingWindow(samplingWindow uint64) error {
+	if !cfg.Pruner.Enabled() {
+		return nil
+	}
+	if samplingWindow == 0 {
+		return fmt.Errorf("module/header: sampling window must be set with header pruning enabled")
+	}
+	if samplingWindow > cfg.Pruner.KeepHeights {
+		return fmt.Errorf("module/header: sampling window of %d headers exceeds %d headers kept by the pruner",
+			samplingWindow, cfg.Pruner.KeepHeights)
+	}
+	return nil
+}
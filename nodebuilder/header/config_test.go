@@ -0,0 +1,20 @@
+package header
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+)
+
+func TestConfig_ValidateSamplingWindow(t *testing.T) {
+	cfg := DefaultConfig(node.Light)
+	// any window is valid with pruning disabled
+	require.NoError(t, cfg.ValidateSamplingWindow(0))
+
+	cfg.Pruner.KeepHeights = 100
+	require.NoError(t, cfg.ValidateSamplingWindow(100))
+	require.Error(t, cfg.ValidateSamplingWindow(101))
+	require.Error(t, cfg.ValidateSamplingWindow(0))
+}
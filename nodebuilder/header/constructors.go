@@ -3,6 +3,7 @@ package header
 import (
 	"context"
 
+	"github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
@@ -16,8 +17,11 @@ import (
 	"github.com/celestiaorg/go-header/sync"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/pruner"
 	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 )
 
@@ -82,6 +86,35 @@ func newSyncer(
 	}, nil
 }
 
+type prunerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Store     libhead.Store[*header.ExtendedHeader]
+	Datastore datastore.Batching
+	// the cached sampling results are pruned along with the headers
+	Availability share.Availability `optional:"true"`
+}
+
+// newPruner constructs a new Pruner for the header store.
+func newPruner(cfg Config, params prunerParams) (*pruner.Pruner, error) {
+	var pruneFn []pruner.PruneFn
+	if ca, ok := params.Availability.(*cache.ShareAvailability); ok {
+		pruneFn = append(pruneFn, func(ctx context.Context, h *header.ExtendedHeader) error {
+			return ca.Prune(ctx, uint64(h.Height()), h.DAH)
+		})
+	}
+	p, err := pruner.NewPruner(cfg.Pruner, params.Store, params.Datastore, pruneFn...)
+	if err != nil {
+		return nil, err
+	}
+	params.Lifecycle.Append(fx.Hook{
+		OnStart: p.Start,
+		OnStop:  p.Stop,
+	})
+	return p, nil
+}
+
 // InitStore is a type representing initialized header store.
 // NOTE: It is needed to ensure that Store is always initialized before Syncer is started.
 type InitStore libhead.Store[*header.ExtendedHeader]
@@ -15,6 +15,7 @@ import (
 	"github.com/celestiaorg/go-header/sync"
 
 	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/pruner"
 	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
@@ -92,7 +93,17 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 	)
 
 	switch tp {
-	case node.Light, node.Full:
+	case node.Light:
+		return fx.Module(
+			"header",
+			baseComponents,
+			fx.Provide(newP2PExchange),
+			fx.Invoke(func(pruner *pruner.Pruner) {}),
+			// lifecycle hooks of the pruner are appended by its constructor, as annotated hooks can't
+			// depend on the optional values
+			fx.Provide(newPruner),
+		)
+	case node.Full:
 		return fx.Module(
 			"header",
 			baseComponents,
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/celestiaorg/celestia-node/libs/fxutil"
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
 	"github.com/celestiaorg/celestia-node/nodebuilder/core"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
 	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
@@ -30,6 +31,8 @@ func ConstructModule(tp node.Type, network p2p.Network, cfg *Config, store Store
 	}
 
 	baseComponents := fx.Options(
+		// the pruned headers can't be sampled
+		fx.Error(cfg.Header.ValidateSamplingWindow(cfg.DASer.SamplingWindow)),
 		fx.Supply(tp),
 		fx.Supply(network),
 		fx.Provide(p2p.BootstrappersFor),
@@ -52,6 +55,7 @@ func ConstructModule(tp node.Type, network p2p.Network, cfg *Config, store Store
 		core.ConstructModule(tp, &cfg.Core),
 		das.ConstructModule(tp, &cfg.DASer),
 		fraud.ConstructModule(tp),
+		blob.ConstructModule(tp),
 		node.ConstructModule(tp),
 	)
 
@@ -2,21 +2,34 @@ package node
 
 import (
 	"context"
-	"fmt"
+	"sync"
+	"time"
 
 	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
 )
 
 const APIVersion = "v0.1.0"
 
 type module struct {
 	tp Type
+
+	rotateLk sync.Mutex
+	keyring  *perms.Keyring
+	ks       keystore.Keystore
+	revoked  datastore.Datastore
 }
 
-func newModule(tp Type) Module {
+func newModule(tp Type, keyring *perms.Keyring, ks keystore.Keystore, ds datastore.Datastore) Module {
 	return &module{
-		tp: tp,
+		tp:      tp,
+		keyring: keyring,
+		ks:      ks,
+		revoked: revokedStore(ds),
 	}
 }
 
@@ -38,10 +51,39 @@ func (m *module) LogLevelSet(_ context.Context, name, level string) error {
 	return logging.SetLogLevel(name, level)
 }
 
-func (m *module) AuthVerify(context.Context, string) ([]auth.Permission, error) {
-	return []auth.Permission{}, fmt.Errorf("not implemented")
+func (m *module) AuthVerify(_ context.Context, token string) ([]auth.Permission, error) {
+	p, err := m.keyring.Verify(token)
+	if err != nil {
+		return []auth.Permission{}, err
+	}
+	return p.Allow, nil
+}
+
+func (m *module) AuthNew(_ context.Context, permissions []auth.Permission) ([]byte, error) {
+	return perms.NewTokenWithPerms(m.keyring.Signer(), permissions)
+}
+
+func (m *module) AuthNewScoped(
+	_ context.Context,
+	permissions []auth.Permission,
+	ttl time.Duration,
+	scope []string,
+) ([]byte, error) {
+	return perms.NewScopedToken(m.keyring.Signer(), permissions, ttl, scope)
+}
+
+func (m *module) AuthRotate(_ context.Context, grace time.Duration) error {
+	m.rotateLk.Lock()
+	defer m.rotateLk.Unlock()
+
+	signer, err := RotateSecret(m.ks, grace)
+	if err != nil {
+		return err
+	}
+	m.keyring.Rotate(signer, grace)
+	return nil
 }
 
-func (m *module) AuthNew(context.Context, []auth.Permission) ([]byte, error) {
-	return nil, fmt.Errorf("not implemented")
+func (m *module) AuthRevoke(ctx context.Context, id string, expiresAt time.Time) error {
+	return revoke(ctx, m.keyring, m.revoked, id, expiresAt)
 }
@@ -1,15 +1,40 @@
 package node
 
 import (
+	"context"
 	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/cristalhq/jwt"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	"go.uber.org/fx"
 
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/libs/keystore"
 )
 
-var SecretName = keystore.KeyName("jwt-secret.jwt")
+var (
+	SecretName = keystore.KeyName("jwt-secret.jwt")
+	// RotatedSecretsName is the name of the key holding the secrets the JWT secret was rotated
+	// from, which keep verifying tokens until their grace window ends.
+	RotatedSecretsName = keystore.KeyName("jwt-secret-rotated.jwt")
+)
+
+// revokedPrefix is the datastore prefix the revoked tokens are persisted under.
+var revokedPrefix = datastore.NewKey("auth/revoked")
+
+// rotatedSecret is the persisted form of the secret the JWT secret was rotated from.
+type rotatedSecret struct {
+	Secret     []byte    `json:"secret"`
+	ValidUntil time.Time `json:"valid_until"`
+}
 
 // secret returns the node's JWT secret if it exists, or generates
 // and saves a new one if it does not.
@@ -19,6 +44,23 @@ func secret(ks keystore.Keystore) (jwt.Signer, error) {
 		return jwt.NewHS256(pk)
 	}
 	// otherwise, generate and save new priv key
+	sk, err := newSecret(ks)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.NewHS256(sk)
+}
+
+func existing(ks keystore.Keystore) ([]byte, bool) {
+	sk, err := ks.Get(SecretName)
+	if err != nil {
+		return nil, false
+	}
+	return sk.Body, true
+}
+
+// newSecret generates and saves a new JWT secret.
+func newSecret(ks keystore.Keystore) ([]byte, error) {
 	sk, err := io.ReadAll(io.LimitReader(rand.Reader, 32))
 	if err != nil {
 		return nil, err
@@ -28,14 +70,168 @@ func secret(ks keystore.Keystore) (jwt.Signer, error) {
 	if err != nil {
 		return nil, err
 	}
+	return sk, nil
+}
+
+// RotateSecret replaces the JWT secret stored in the given Keystore with a newly generated one,
+// keeping the replaced secret around to verify tokens for the given grace window. The new secret
+// is returned.
+func RotateSecret(ks keystore.Keystore, grace time.Duration) (jwt.Signer, error) {
+	rotated, err := loadRotated(ks)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	valid := rotated[:0]
+	for _, r := range rotated {
+		if now.Before(r.ValidUntil) {
+			valid = append(valid, r)
+		}
+	}
+	sk, ok := existing(ks)
+	if ok && grace > 0 {
+		valid = append(valid, rotatedSecret{Secret: sk, ValidUntil: now.Add(grace)})
+	}
 
+	// persist the rotated secrets first, so that the replaced secret is never lost
+	body, err := json.Marshal(valid)
+	if err != nil {
+		return nil, err
+	}
+	err = replaceKey(ks, RotatedSecretsName, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		err = ks.Delete(SecretName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sk, err = newSecret(ks)
+	if err != nil {
+		return nil, err
+	}
 	return jwt.NewHS256(sk)
 }
 
-func existing(ks keystore.Keystore) ([]byte, bool) {
-	sk, err := ks.Get(SecretName)
+func loadRotated(ks keystore.Keystore) ([]rotatedSecret, error) {
+	key, err := ks.Get(RotatedSecretsName)
 	if err != nil {
-		return nil, false
+		if errors.Is(err, keystore.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
 	}
-	return sk.Body, true
+
+	var rotated []rotatedSecret
+	return rotated, json.Unmarshal(key.Body, &rotated)
+}
+
+// replaceKey stores the given body under the given name, overwriting the existing key if any.
+func replaceKey(ks keystore.Keystore, name keystore.KeyName, body []byte) error {
+	_, err := ks.Get(name)
+	switch {
+	case err == nil:
+		err = ks.Delete(name)
+		if err != nil {
+			return err
+		}
+	case !errors.Is(err, keystore.ErrNotFound):
+		return err
+	}
+	return ks.Put(name, keystore.PrivKey{Body: body})
+}
+
+// keyring constructs the perms.Keyring verifying tokens with the given secret and the secrets it
+// was rotated from. The persisted list of revoked tokens is loaded on start.
+func keyring(
+	lc fx.Lifecycle,
+	signer jwt.Signer,
+	ks keystore.Keystore,
+	ds datastore.Batching,
+) (*perms.Keyring, error) {
+	kr := perms.NewKeyring(signer)
+
+	rotated, err := loadRotated(ks)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rotated {
+		rs, err := jwt.NewHS256(r.Secret)
+		if err != nil {
+			return nil, err
+		}
+		kr.AddRotated(perms.RotatedSigner{Signer: rs, ValidUntil: r.ValidUntil})
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return loadRevoked(ctx, kr, revokedStore(ds))
+		},
+	})
+	return kr, nil
+}
+
+// loadRevoked adds the persisted revoked tokens to the given Keyring, dropping the ones that have
+// expired anyway.
+func loadRevoked(ctx context.Context, kr *perms.Keyring, ds datastore.Datastore) error {
+	results, err := ds.Query(ctx, query.Query{})
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		var expiresAt time.Time
+		err = expiresAt.UnmarshalBinary(entry.Value)
+		if err != nil {
+			return err
+		}
+
+		key := datastore.RawKey(entry.Key)
+		if !expiresAt.IsZero() && !now.Before(expiresAt) {
+			err = ds.Delete(ctx, key)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		kr.Revoke(key.BaseNamespace(), expiresAt)
+	}
+	return nil
+}
+
+// revoke adds the token with the given ID and expiry to the revocation list of the given Keyring
+// and persists it. The ID is dropped from the list once the token expires, while zero expiry keeps
+// it revoked for good. Tokens that have already expired are not recorded.
+func revoke(ctx context.Context, kr *perms.Keyring, ds datastore.Datastore, id string, expiresAt time.Time) error {
+	if _, err := hex.DecodeString(id); err != nil || id == "" {
+		return fmt.Errorf("node: invalid token ID %q", id)
+	}
+	if !expiresAt.IsZero() && !time.Now().Before(expiresAt) {
+		return nil
+	}
+
+	value, err := expiresAt.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = ds.Put(ctx, datastore.NewKey(id), value)
+	if err != nil {
+		return err
+	}
+
+	kr.Revoke(id, expiresAt)
+	return nil
+}
+
+func revokedStore(ds datastore.Datastore) datastore.Datastore {
+	return namespace.Wrap(ds, revokedPrefix)
 }
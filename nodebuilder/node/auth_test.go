@@ -0,0 +1,112 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+func TestRotateAndRevoke(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	ks := keystore.NewMapKeystore()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+
+	signer, err := secret(ks)
+	require.NoError(t, err)
+	kr, err := keyring(fxtest.NewLifecycle(t), signer, ks, ds)
+	require.NoError(t, err)
+	mod := newModule(Full, kr, ks, ds)
+
+	oldToken, err := mod.AuthNew(ctx, perms.AllPerms)
+	require.NoError(t, err)
+	revokedToken, err := mod.AuthNew(ctx, perms.AllPerms)
+	require.NoError(t, err)
+
+	err = mod.AuthRotate(ctx, time.Hour)
+	require.NoError(t, err)
+	// tokens are revoked by their ID, without handing over the token itself
+	p, err := perms.UnverifiedPayload(string(revokedToken))
+	require.NoError(t, err)
+	err = mod.AuthRevoke(ctx, p.ID, p.Expiry())
+	require.NoError(t, err)
+	err = mod.AuthRevoke(ctx, string(revokedToken), time.Time{})
+	require.Error(t, err)
+
+	newToken, err := mod.AuthNew(ctx, perms.ReadPerms)
+	require.NoError(t, err)
+	_, err = mod.AuthVerify(ctx, string(oldToken))
+	require.NoError(t, err)
+	_, err = mod.AuthVerify(ctx, string(newToken))
+	require.NoError(t, err)
+	_, err = mod.AuthVerify(ctx, string(revokedToken))
+	require.ErrorIs(t, err, perms.ErrTokenRevoked)
+
+	// the rotated secrets and the revocation list survive restarts
+	signer, err = secret(ks)
+	require.NoError(t, err)
+	lc := fxtest.NewLifecycle(t)
+	kr, err = keyring(lc, signer, ks, ds)
+	require.NoError(t, err)
+	lc.RequireStart()
+	t.Cleanup(lc.RequireStop)
+
+	_, err = kr.Verify(string(oldToken))
+	require.NoError(t, err)
+	_, err = kr.Verify(string(newToken))
+	require.NoError(t, err)
+	_, err = kr.Verify(string(revokedToken))
+	require.ErrorIs(t, err, perms.ErrTokenRevoked)
+
+	// rotating without grace window does not keep the replaced secret around
+	_, err = RotateSecret(ks, 0)
+	require.NoError(t, err)
+	rotated, err := loadRotated(ks)
+	require.NoError(t, err)
+	require.Len(t, rotated, 1)
+}
+
+func TestRevokeExpiry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	signer, err := secret(keystore.NewMapKeystore())
+	require.NoError(t, err)
+	kr := perms.NewKeyring(signer)
+	ds := revokedStore(ds_sync.MutexWrap(datastore.NewMapDatastore()))
+
+	// tokens that have already expired are not recorded
+	err = revoke(ctx, kr, ds, "aa", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.False(t, kr.IsRevoked("aa"))
+	has, err := ds.Has(ctx, datastore.NewKey("aa"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	err = revoke(ctx, kr, ds, "bb", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	err = revoke(ctx, kr, ds, "cc", time.Now().Add(time.Millisecond*50))
+	require.NoError(t, err)
+	require.True(t, kr.IsRevoked("bb"))
+	require.True(t, kr.IsRevoked("cc"))
+
+	// the revocations are dropped once the tokens expire
+	time.Sleep(time.Millisecond * 100)
+	kr = perms.NewKeyring(signer)
+	err = loadRevoked(ctx, kr, ds)
+	require.NoError(t, err)
+	require.True(t, kr.IsRevoked("bb"))
+	require.False(t, kr.IsRevoked("cc"))
+	has, err = ds.Has(ctx, datastore.NewKey("cc"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	auth "github.com/filecoin-project/go-jsonrpc/auth"
 	gomock "github.com/golang/mock/gomock"
@@ -52,6 +53,49 @@ func (mr *MockModuleMockRecorder) AuthNew(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNew", reflect.TypeOf((*MockModule)(nil).AuthNew), arg0, arg1)
 }
 
+// AuthNewScoped mocks base method.
+func (m *MockModule) AuthNewScoped(arg0 context.Context, arg1 []auth.Permission, arg2 time.Duration, arg3 []string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthNewScoped", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthNewScoped indicates an expected call of AuthNewScoped.
+func (mr *MockModuleMockRecorder) AuthNewScoped(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNewScoped", reflect.TypeOf((*MockModule)(nil).AuthNewScoped), arg0, arg1, arg2, arg3)
+}
+
+// AuthRevoke mocks base method.
+func (m *MockModule) AuthRevoke(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRevoke", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthRevoke indicates an expected call of AuthRevoke.
+func (mr *MockModuleMockRecorder) AuthRevoke(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRevoke", reflect.TypeOf((*MockModule)(nil).AuthRevoke), arg0, arg1, arg2)
+}
+
+// AuthRotate mocks base method.
+func (m *MockModule) AuthRotate(arg0 context.Context, arg1 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRotate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthRotate indicates an expected call of AuthRotate.
+func (mr *MockModuleMockRecorder) AuthRotate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRotate", reflect.TypeOf((*MockModule)(nil).AuthRotate), arg0, arg1)
+}
+
 // AuthVerify mocks base method.
 func (m *MockModule) AuthVerify(arg0 context.Context, arg1 string) ([]auth.Permission, error) {
 	m.ctrl.T.Helper()
@@ -1,16 +1,20 @@
 package node
 
 import (
-	"github.com/cristalhq/jwt"
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/fx"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
 )
 
 func ConstructModule(tp Type) fx.Option {
 	return fx.Module(
 		"node",
-		fx.Provide(func(secret jwt.Signer) Module {
-			return newModule(tp)
+		fx.Provide(func(kr *perms.Keyring, ks keystore.Keystore, ds datastore.Batching) Module {
+			return newModule(tp, kr, ks, ds)
 		}),
 		fx.Provide(secret),
+		fx.Provide(keyring),
 	)
 }
@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"time"
 
 	"github.com/filecoin-project/go-jsonrpc/auth"
 )
@@ -21,16 +22,41 @@ type Module interface {
 	AuthVerify(ctx context.Context, token string) ([]auth.Permission, error)
 	// AuthNew signs and returns a new token with the given permissions.
 	AuthNew(ctx context.Context, perms []auth.Permission) ([]byte, error)
+	// AuthNewScoped signs and returns a new token with the given permissions that expires after
+	// the given ttl and can only invoke the methods within the given scope. The scope lists modules
+	// (e.g. "share") or module methods (e.g. "header.GetByHeight"). Zero ttl and empty scope leave
+	// the token unrestricted in the respective dimension.
+	AuthNewScoped(
+		ctx context.Context,
+		perms []auth.Permission,
+		ttl time.Duration,
+		scope []string,
+	) ([]byte, error)
+	// AuthRotate replaces the secret tokens are signed with by a newly generated one. Tokens signed
+	// with the replaced secret keep being accepted for the given grace window.
+	AuthRotate(ctx context.Context, grace time.Duration) error
+	// AuthRevoke revokes the token with the given ID (its "jti" claim), so that it is no longer
+	// accepted. The token itself is not required. The given expiry of the token (its "exp" claim)
+	// bounds how long the revocation is kept; zero expiry keeps it for good.
+	AuthRevoke(ctx context.Context, id string, expiresAt time.Time) error
 }
 
 var _ Module = (*API)(nil)
 
 type API struct {
 	Internal struct {
-		Info        func(context.Context) (Info, error)                                `perm:"admin"`
-		LogLevelSet func(ctx context.Context, name, level string) error                `perm:"admin"`
-		AuthVerify  func(ctx context.Context, token string) ([]auth.Permission, error) `perm:"admin"`
-		AuthNew     func(ctx context.Context, perms []auth.Permission) ([]byte, error) `perm:"admin"`
+		Info          func(context.Context) (Info, error)                                `perm:"admin"`
+		LogLevelSet   func(ctx context.Context, name, level string) error                `perm:"admin"`
+		AuthVerify    func(ctx context.Context, token string) ([]auth.Permission, error) `perm:"admin"`
+		AuthNew       func(ctx context.Context, perms []auth.Permission) ([]byte, error) `perm:"admin"`
+		AuthNewScoped func(
+			ctx context.Context,
+			perms []auth.Permission,
+			ttl time.Duration,
+			scope []string,
+		) ([]byte, error) `perm:"admin"`
+		AuthRotate func(ctx context.Context, grace time.Duration) error            `perm:"admin"`
+		AuthRevoke func(ctx context.Context, id string, expiresAt time.Time) error `perm:"admin"`
 	}
 }
 
@@ -49,3 +75,20 @@ func (api *API) AuthVerify(ctx context.Context, token string) ([]auth.Permission
 func (api *API) AuthNew(ctx context.Context, perms []auth.Permission) ([]byte, error) {
 	return api.Internal.AuthNew(ctx, perms)
 }
+
+func (api *API) AuthNewScoped(
+	ctx context.Context,
+	perms []auth.Permission,
+	ttl time.Duration,
+	scope []string,
+) ([]byte, error) {
+	return api.Internal.AuthNewScoped(ctx, perms, ttl, scope)
+}
+
+func (api *API) AuthRotate(ctx context.Context, grace time.Duration) error {
+	return api.Internal.AuthRotate(ctx, grace)
+}
+
+func (api *API) AuthRevoke(ctx context.Context, id string, expiresAt time.Time) error {
+	return api.Internal.AuthRevoke(ctx, id, expiresAt)
+}
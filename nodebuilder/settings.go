@@ -86,6 +86,7 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type, buildIn
 		opts = fx.Options(
 			baseComponents,
 			fx.Invoke(share.WithShrexServerMetrics),
+			fx.Invoke(share.WithPrunerMetrics),
 			samplingMetrics,
 		)
 	case node.Light:
@@ -97,6 +98,7 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type, buildIn
 		opts = fx.Options(
 			baseComponents,
 			fx.Invoke(share.WithShrexServerMetrics),
+			fx.Invoke(share.WithPrunerMetrics),
 		)
 	default:
 		panic("invalid node type")
@@ -6,9 +6,12 @@ import (
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/share/availability/discovery"
 	"github.com/celestiaorg/celestia-node/share/availability/light"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/getters"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 // TODO: some params are pointers and other are not, Let's fix this.
@@ -18,11 +21,17 @@ type Config struct {
 	ShrExEDSParams *shrexeds.Parameters
 	// ShrExNDParams sets shrexnd client and server configuration parameters
 	ShrExNDParams *shrexnd.Parameters
+	// ShrExSampleParams sets shrexsample client and server configuration parameters
+	ShrExSampleParams *shrexsample.Parameters
 	// PeerManagerParams sets peer-manager configuration parameters
 	PeerManagerParams peers.Parameters
+	// ShrexHedge sets racing of shrex requests across multiple peers
+	ShrexHedge getters.HedgeParameters
 
 	LightAvailability light.Parameters `toml:",omitempty"`
 	Discovery         discovery.Parameters
+	// Pruner sets the retention window of the EDS store on full and bridge nodes
+	Pruner eds.PrunerParameters `toml:",omitempty"`
 }
 
 func DefaultConfig(tp node.Type) Config {
@@ -30,12 +39,16 @@ func DefaultConfig(tp node.Type) Config {
 		Discovery:         discovery.DefaultParameters(),
 		ShrExEDSParams:    shrexeds.DefaultParameters(),
 		ShrExNDParams:     shrexnd.DefaultParameters(),
+		ShrExSampleParams: shrexsample.DefaultParameters(),
 		UseShareExchange:  true,
 		PeerManagerParams: peers.DefaultParameters(),
+		ShrexHedge:        getters.DefaultHedgeParameters(),
 	}
 
 	if tp == node.Light {
 		cfg.LightAvailability = light.DefaultParameters()
+	} else {
+		cfg.Pruner = eds.DefaultPrunerParameters()
 	}
 
 	return cfg
@@ -47,6 +60,10 @@ func (cfg *Config) Validate(tp node.Type) error {
 		if err := cfg.LightAvailability.Validate(); err != nil {
 			return fmt.Errorf("nodebuilder/share: %w", err)
 		}
+	} else {
+		if err := cfg.Pruner.Validate(); err != nil {
+			return fmt.Errorf("nodebuilder/share: %w", err)
+		}
 	}
 
 	if err := cfg.Discovery.Validate(); err != nil {
@@ -61,6 +78,14 @@ func (cfg *Config) Validate(tp node.Type) error {
 		return fmt.Errorf("nodebuilder/share: %w", err)
 	}
 
+	if err := cfg.ShrExSampleParams.Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/share: %w", err)
+	}
+
+	if err := cfg.ShrexHedge.Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/share: %w", err)
+	}
+
 	if err := cfg.PeerManagerParams.Validate(); err != nil {
 		return fmt.Errorf("nodebuilder/share: %w", err)
 	}
@@ -29,12 +29,40 @@ func newDiscovery(cfg Config) func(routing.ContentRouting, host.Host) *disc.Disc
 		return disc.NewDiscovery(
 			h,
 			routingdisc.NewRoutingDiscovery(r),
+			disc.FullNodesTag,
 			disc.WithPeersLimit(cfg.Discovery.PeersLimit),
 			disc.WithAdvertiseInterval(cfg.Discovery.AdvertiseInterval),
 		)
 	}
 }
 
+// newArchivalDiscovery constructs the Discovery of full nodes that do not prune the history.
+// Archival nodes are only looked for if the peer manager is configured to prefer them.
+func newArchivalDiscovery(cfg Config) func(fx.Lifecycle, routing.ContentRouting, host.Host) *disc.Discovery {
+	return func(
+		lc fx.Lifecycle,
+		r routing.ContentRouting,
+		h host.Host,
+	) *disc.Discovery {
+		var peersLimit uint
+		if cfg.PeerManagerParams.ArchivalWindow > 0 {
+			peersLimit = cfg.Discovery.PeersLimit
+		}
+		d := disc.NewDiscovery(
+			h,
+			routingdisc.NewRoutingDiscovery(r),
+			disc.ArchivalNodesTag,
+			disc.WithPeersLimit(peersLimit),
+			disc.WithAdvertiseInterval(cfg.Discovery.AdvertiseInterval),
+		)
+		lc.Append(fx.Hook{
+			OnStart: d.Start,
+			OnStop:  d.Stop,
+		})
+		return d
+	}
+}
+
 // cacheAvailability wraps light availability with a cache for result sampling.
 func cacheAvailability(lc fx.Lifecycle, ds datastore.Batching, avail *light.ShareAvailability) share.Availability {
 	ca := cache.NewShareAvailability(avail, ds)
@@ -54,6 +54,36 @@ func (mr *MockModuleMockRecorder) GetEDS(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEDS", reflect.TypeOf((*MockModule)(nil).GetEDS), arg0, arg1)
 }
 
+// GetRange mocks base method.
+func (m *MockModule) GetRange(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2, arg3 int) (share.RangeShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(share.RangeShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRange indicates an expected call of GetRange.
+func (mr *MockModuleMockRecorder) GetRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockModule)(nil).GetRange), arg0, arg1, arg2, arg3)
+}
+
+// GetRow mocks base method.
+func (m *MockModule) GetRow(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 int, arg3 rsmt2d.Axis) (*share.AxisShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*share.AxisShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRow indicates an expected call of GetRow.
+func (mr *MockModuleMockRecorder) GetRow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRow", reflect.TypeOf((*MockModule)(nil).GetRow), arg0, arg1, arg2, arg3)
+}
+
 // GetShare mocks base method.
 func (m *MockModule) GetShare(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2, arg3 int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +114,21 @@ func (mr *MockModuleMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockModule)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
 
+// GetSharesByNamespaces mocks base method.
+func (m *MockModule) GetSharesByNamespaces(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 []namespace.ID) (share.NamespacedSharesMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaces", arg0, arg1, arg2)
+	ret0, _ := ret[0].(share.NamespacedSharesMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaces indicates an expected call of GetSharesByNamespaces.
+func (mr *MockModuleMockRecorder) GetSharesByNamespaces(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaces", reflect.TypeOf((*MockModule)(nil).GetSharesByNamespaces), arg0, arg1, arg2)
+}
+
 // ProbabilityOfAvailability mocks base method.
 func (m *MockModule) ProbabilityOfAvailability(arg0 context.Context) float64 {
 	m.ctrl.T.Helper()
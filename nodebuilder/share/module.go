@@ -7,6 +7,9 @@ import (
 	"github.com/libp2p/go-libp2p/core/host"
 	"go.uber.org/fx"
 
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/libs/fxutil"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	modp2p "github.com/celestiaorg/celestia-node/nodebuilder/p2p"
@@ -19,6 +22,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
@@ -41,6 +45,12 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return d.Stop(ctx)
 			}),
 		)),
+		// lifecycle hooks of the archival discovery are appended by its constructor, as annotated
+		// hooks can't depend on the named values
+		fx.Provide(fx.Annotate(
+			newArchivalDiscovery(*cfg),
+			fx.ResultTags(`name:"archival"`),
+		)),
 		fx.Provide(
 			func(ctx context.Context, h host.Host, network modp2p.Network) (*shrexsub.PubSub, error) {
 				return shrexsub.NewPubSub(ctx, h, network.String())
@@ -50,7 +60,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 
 	bridgeAndFullComponents := fx.Options(
 		fx.Provide(getters.NewStoreGetter),
-		fx.Invoke(func(edsSrv *shrexeds.Server, ndSrc *shrexnd.Server) {}),
+		fx.Invoke(func(edsSrv *shrexeds.Server, ndSrc *shrexnd.Server, sampleSrv *shrexsample.Server) {}),
 		fx.Provide(fx.Annotate(
 			func(host host.Host, store *eds.Store, network modp2p.Network) (*shrexeds.Server, error) {
 				cfg.ShrExEDSParams.WithNetworkID(network.String())
@@ -80,6 +90,18 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return server.Stop(ctx)
 			}),
 		)),
+		fx.Provide(fx.Annotate(
+			func(host host.Host, store *eds.Store, network modp2p.Network) (*shrexsample.Server, error) {
+				cfg.ShrExSampleParams.WithNetworkID(network.String())
+				return shrexsample.NewServer(cfg.ShrExSampleParams, host, store)
+			},
+			fx.OnStart(func(ctx context.Context, server *shrexsample.Server) error {
+				return server.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, server *shrexsample.Server) error {
+				return server.Stop(ctx)
+			}),
+		)),
 		fx.Provide(fx.Annotate(
 			func(path node.StorePath, ds datastore.Batching) (*eds.Store, error) {
 				return eds.NewStore(string(path), ds)
@@ -95,8 +117,36 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return store.Stop(ctx)
 			}),
 		)),
+		fx.Invoke(func(pruner *eds.Pruner) {}),
 		fx.Provide(fx.Annotate(
-			full.NewShareAvailability,
+			func(
+				store *eds.Store,
+				hstore libhead.Store[*header.ExtendedHeader],
+				ds datastore.Batching,
+			) (*eds.Pruner, error) {
+				return eds.NewPruner(cfg.Pruner, store, hstore, ds)
+			},
+			fx.OnStart(func(ctx context.Context, pruner *eds.Pruner) error {
+				return pruner.Start(ctx)
+			}),
+			fx.OnStop(func(ctx context.Context, pruner *eds.Pruner) error {
+				return pruner.Stop(ctx)
+			}),
+		)),
+		fx.Provide(fx.Annotate(
+			func(
+				store *eds.Store,
+				getter share.Getter,
+				discovery *disc.Discovery,
+				archival *disc.Discovery,
+			) *full.ShareAvailability {
+				// pruning nodes must not be discovered as archival ones
+				if cfg.Pruner.Enabled() {
+					archival = nil
+				}
+				return full.NewShareAvailability(store, getter, discovery, archival)
+			},
+			fx.ParamTags(``, ``, ``, `name:"archival"`),
 			fx.OnStart(func(ctx context.Context, avail *full.ShareAvailability) error {
 				return avail.Start(ctx)
 			}),
@@ -116,7 +166,10 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 		fx.Provide(func() peers.Parameters {
 			return cfg.PeerManagerParams
 		}),
-		fx.Provide(peers.NewManager),
+		fx.Provide(fx.Annotate(
+			peers.NewManager,
+			fx.ParamTags(``, ``, ``, ``, `name:"archival"`),
+		)),
 		fx.Provide(
 			func(host host.Host, network modp2p.Network) (*shrexnd.Client, error) {
 				cfg.ShrExNDParams.WithNetworkID(network.String())
@@ -129,6 +182,15 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return shrexeds.NewClient(cfg.ShrExEDSParams, host)
 			},
 		),
+		fx.Provide(
+			func(host host.Host, network modp2p.Network) (*shrexsample.Client, error) {
+				cfg.ShrExSampleParams.WithNetworkID(network.String())
+				return shrexsample.NewClient(cfg.ShrExSampleParams, host)
+			},
+		),
+		fx.Provide(func() getters.HedgeParameters {
+			return cfg.ShrexHedge
+		}),
 		fx.Provide(fx.Annotate(
 			getters.NewShrexGetter,
 			fx.OnStart(func(ctx context.Context, getter *getters.ShrexGetter) error {
@@ -173,6 +235,11 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			fx.Provide(func() []light.Option {
 				return []light.Option{
 					light.WithSampleAmount(cfg.LightAvailability.SampleAmount),
+					light.WithConfidence(cfg.LightAvailability.Confidence),
+					light.WithAdaptiveSampling(
+						cfg.LightAvailability.MaxExtraSamples,
+						cfg.LightAvailability.SampleTimeout,
+					),
 				}
 			}),
 			shrexGetterComponents,
@@ -1,10 +1,12 @@
 package share
 
 import (
+	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/getters"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
 // WithPeerManagerMetrics is a utility function that is expected to be
@@ -13,22 +15,46 @@ func WithPeerManagerMetrics(m *peers.Manager) error {
 	return m.WithMetrics()
 }
 
-func WithShrexClientMetrics(edsClient *shrexeds.Client, ndClient *shrexnd.Client) error {
+func WithShrexClientMetrics(
+	edsClient *shrexeds.Client,
+	ndClient *shrexnd.Client,
+	sampleClient *shrexsample.Client,
+) error {
 	err := edsClient.WithMetrics()
 	if err != nil {
 		return err
 	}
 
-	return ndClient.WithMetrics()
+	err = ndClient.WithMetrics()
+	if err != nil {
+		return err
+	}
+
+	return sampleClient.WithMetrics()
 }
 
-func WithShrexServerMetrics(edsServer *shrexeds.Server, ndServer *shrexnd.Server) error {
+func WithShrexServerMetrics(
+	edsServer *shrexeds.Server,
+	ndServer *shrexnd.Server,
+	sampleServer *shrexsample.Server,
+) error {
 	err := edsServer.WithMetrics()
 	if err != nil {
 		return err
 	}
 
-	return ndServer.WithMetrics()
+	err = ndServer.WithMetrics()
+	if err != nil {
+		return err
+	}
+
+	return sampleServer.WithMetrics()
+}
+
+// WithPrunerMetrics is a utility function that is expected to be
+// "invoked" by the fx lifecycle.
+func WithPrunerMetrics(p *eds.Pruner) error {
+	return p.WithMetrics()
 }
 
 func WithShrexGetterMetrics(sg *getters.ShrexGetter) error {
@@ -41,6 +41,20 @@ type Module interface {
 	// GetSharesByNamespace gets all shares from an EDS within the given namespace.
 	// Shares are returned in a row-by-row order if the namespace spans multiple rows.
 	GetSharesByNamespace(ctx context.Context, root *share.Root, namespace namespace.ID) (share.NamespacedShares, error)
+	// GetSharesByNamespaces gets all shares from an EDS within any of the given namespaces at once.
+	// Namespaces not present in the EDS are omitted from the result.
+	GetSharesByNamespaces(
+		ctx context.Context,
+		root *share.Root,
+		namespaces []namespace.ID,
+	) (share.NamespacedSharesMap, error)
+	// GetRow gets all the shares of the row or column with the given index from an EDS along with
+	// the proof of their inclusion into the axis root.
+	GetRow(ctx context.Context, root *share.Root, idx int, axis rsmt2d.Axis) (*share.AxisShares, error)
+	// GetRange gets the shares of the original data square within the range of share indexes
+	// [start, end) counted row-by-row. Shares are returned split by rows, each with the proof of
+	// their inclusion into the row root.
+	GetRange(ctx context.Context, root *share.Root, start, end int) (share.RangeShares, error)
 }
 
 // API is a wrapper around Module for the RPC.
@@ -63,6 +77,22 @@ type API struct {
 			root *share.Root,
 			namespace namespace.ID,
 		) (share.NamespacedShares, error) `perm:"public"`
+		GetSharesByNamespaces func(
+			ctx context.Context,
+			root *share.Root,
+			namespaces []namespace.ID,
+		) (share.NamespacedSharesMap, error) `perm:"public"`
+		GetRow func(
+			ctx context.Context,
+			root *share.Root,
+			idx int,
+			axis rsmt2d.Axis,
+		) (*share.AxisShares, error) `perm:"public"`
+		GetRange func(
+			ctx context.Context,
+			root *share.Root,
+			start, end int,
+		) (share.RangeShares, error) `perm:"public"`
 	}
 }
 
@@ -90,6 +120,27 @@ func (api *API) GetSharesByNamespace(
 	return api.Internal.GetSharesByNamespace(ctx, root, namespace)
 }
 
+func (api *API) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []namespace.ID,
+) (share.NamespacedSharesMap, error) {
+	return api.Internal.GetSharesByNamespaces(ctx, root, namespaces)
+}
+
+func (api *API) GetRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisShares, error) {
+	return api.Internal.GetRow(ctx, root, idx, axis)
+}
+
+func (api *API) GetRange(ctx context.Context, root *share.Root, start, end int) (share.RangeShares, error) {
+	return api.Internal.GetRange(ctx, root, start, end)
+}
+
 type module struct {
 	share.Getter
 	share.Availability
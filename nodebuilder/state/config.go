@@ -1,6 +1,12 @@
 package state
 
-import "github.com/cosmos/cosmos-sdk/crypto/keyring"
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"github.com/celestiaorg/celestia-node/state"
+)
 
 var defaultKeyringBackend = keyring.BackendTest
 
@@ -9,16 +15,31 @@ var defaultKeyringBackend = keyring.BackendTest
 type Config struct {
 	KeyringAccName string
 	KeyringBackend string
+	// Accounts are the names of the keyring accounts, besides the default one, that can be selected
+	// to sign transactions.
+	Accounts []string
+	// GasPrice is the price in utia paid per unit of gas for the PayForBlob transactions
+	// submitted without the fee.
+	GasPrice float64
 }
 
 func DefaultConfig() Config {
 	return Config{
 		KeyringAccName: "",
 		KeyringBackend: defaultKeyringBackend,
+		GasPrice:       state.DefaultGasPrice,
 	}
 }
 
 // Validate performs basic validation of the config.
 func (cfg *Config) Validate() error {
+	if cfg.GasPrice < 0 {
+		return fmt.Errorf("nodebuilder/state: gas price can't be negative")
+	}
+	for _, name := range cfg.Accounts {
+		if name == "" {
+			return fmt.Errorf("nodebuilder/state: account name can't be empty")
+		}
+	}
 	return nil
 }
@@ -1,13 +1,18 @@
 package state
 
 import (
+	"context"
+	"fmt"
+
 	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	libfraud "github.com/celestiaorg/go-fraud"
 	"github.com/celestiaorg/go-header/sync"
 
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/core"
 	modfraud "github.com/celestiaorg/celestia-node/nodebuilder/fraud"
+	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
 	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
 	"github.com/celestiaorg/celestia-node/state"
 )
@@ -16,15 +21,35 @@ import (
 // a celestia-core connection.
 func coreAccessor(
 	corecfg core.Config,
+	cfg Config,
 	signer *apptypes.KeyringSigner,
+	net p2p.Network,
 	sync *sync.Syncer[*header.ExtendedHeader],
 	fraudServ libfraud.Service,
-) (*state.CoreAccessor, *modfraud.ServiceBreaker[*state.CoreAccessor]) {
-	ca := state.NewCoreAccessor(signer, sync, corecfg.IP, corecfg.RPCPort, corecfg.GRPCPort)
+) (*state.CoreAccessor, *modfraud.ServiceBreaker[*state.CoreAccessor], error) {
+	signers, err := accountSigners(cfg, signer, net)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ca := state.NewCoreAccessor(signer, sync, corecfg.IP, corecfg.RPCPort, corecfg.GRPCPort,
+		state.WithGasPrice(cfg.GasPrice),
+		state.WithAccounts(signers...),
+		state.WithAccountGuard(guardAccount),
+	)
 
 	return ca, &modfraud.ServiceBreaker[*state.CoreAccessor]{
 		Service:   ca,
 		FraudType: byzantine.BadEncoding,
 		FraudServ: fraudServ,
+	}, nil
+}
+
+// guardAccount rejects signing with the accounts the token of the RPC request is not scoped to.
+func guardAccount(ctx context.Context, account string) error {
+	p, ok := perms.PayloadFromContext(ctx)
+	if ok && !p.CanSignWith(account) {
+		return fmt.Errorf("token is out of scope to sign with account '%s'", account)
 	}
+	return nil
 }
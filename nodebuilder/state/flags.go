@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	keyringAccNameFlag = "keyring.accname"
-	keyringBackendFlag = "keyring.backend"
+	keyringAccNameFlag  = "keyring.accname"
+	keyringBackendFlag  = "keyring.backend"
+	keyringAccountsFlag = "keyring.accounts"
 )
 
 // Flags gives a set of hardcoded State flags.
@@ -20,6 +21,8 @@ func Flags() *flag.FlagSet {
 		"given string.")
 	flags.String(keyringBackendFlag, defaultKeyringBackend, fmt.Sprintf("Directs node's keyring signer to use the given "+
 		"backend. Default is %s.", defaultKeyringBackend))
+	flags.StringSlice(keyringAccountsFlag, nil, "Comma-separated names of the keys, besides the "+
+		"node's default one, that can be selected to sign transactions.")
 
 	return flags
 }
@@ -32,4 +35,9 @@ func ParseFlags(cmd *cobra.Command, cfg *Config) {
 	}
 
 	cfg.KeyringBackend = cmd.Flag(keyringBackendFlag).Value.String()
+
+	accounts, err := cmd.Flags().GetStringSlice(keyringAccountsFlag)
+	if cmd.Flags().Changed(keyringAccountsFlag) && err == nil {
+		cfg.Accounts = accounts
+	}
 }
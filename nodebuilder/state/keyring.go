@@ -42,3 +42,31 @@ func KeyringSigner(cfg Config, ks keystore.Keystore, net p2p.Network) (*apptypes
 
 	return signer, nil
 }
+
+// accountSigners constructs the signers of the additional keyring accounts the transactions can be
+// signed with. They share the keyring with the default signer.
+func accountSigners(
+	cfg Config,
+	signer *apptypes.KeyringSigner,
+	net p2p.Network,
+) ([]*apptypes.KeyringSigner, error) {
+	if len(cfg.Accounts) == 0 {
+		return nil, nil
+	}
+
+	seen := map[string]bool{signer.GetSignerInfo().Name: true}
+	signers := make([]*apptypes.KeyringSigner, 0, len(cfg.Accounts))
+	for _, name := range cfg.Accounts {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if _, err := signer.Key(name); err != nil {
+			log.Errorw("failed to find key by given name", "account", name)
+			return nil, err
+		}
+		signers = append(signers, apptypes.NewKeyringSigner(signer.Keyring, name, string(net)))
+	}
+	return signers, nil
+}
@@ -14,7 +14,10 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	types1 "github.com/tendermint/tendermint/types"
 
+	types2 "github.com/celestiaorg/celestia-app/x/blob/types"
 	namespace "github.com/celestiaorg/nmt/namespace"
+
+	state "github.com/celestiaorg/celestia-node/state"
 )
 
 // MockModule is a mock of Module interface.
@@ -55,6 +58,21 @@ func (mr *MockModuleMockRecorder) AccountAddress(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAddress", reflect.TypeOf((*MockModule)(nil).AccountAddress), arg0)
 }
 
+// Accounts mocks base method.
+func (m *MockModule) Accounts(arg0 context.Context) ([]state.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accounts", arg0)
+	ret0, _ := ret[0].([]state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accounts indicates an expected call of Accounts.
+func (mr *MockModuleMockRecorder) Accounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accounts", reflect.TypeOf((*MockModule)(nil).Accounts), arg0)
+}
+
 // Balance mocks base method.
 func (m *MockModule) Balance(arg0 context.Context) (*types.Coin, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +118,21 @@ func (mr *MockModuleMockRecorder) BeginRedelegate(arg0, arg1, arg2, arg3, arg4,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRedelegate", reflect.TypeOf((*MockModule)(nil).BeginRedelegate), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// BeginRedelegateWithOptions mocks base method.
+func (m *MockModule) BeginRedelegateWithOptions(arg0 context.Context, arg1, arg2 types.ValAddress, arg3, arg4 math.Int, arg5 uint64, arg6 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginRedelegateWithOptions", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginRedelegateWithOptions indicates an expected call of BeginRedelegateWithOptions.
+func (mr *MockModuleMockRecorder) BeginRedelegateWithOptions(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRedelegateWithOptions", reflect.TypeOf((*MockModule)(nil).BeginRedelegateWithOptions), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // CancelUnbondingDelegation mocks base method.
 func (m *MockModule) CancelUnbondingDelegation(arg0 context.Context, arg1 types.ValAddress, arg2, arg3, arg4 math.Int, arg5 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +148,21 @@ func (mr *MockModuleMockRecorder) CancelUnbondingDelegation(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnbondingDelegation", reflect.TypeOf((*MockModule)(nil).CancelUnbondingDelegation), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// CancelUnbondingDelegationWithOptions mocks base method.
+func (m *MockModule) CancelUnbondingDelegationWithOptions(arg0 context.Context, arg1 types.ValAddress, arg2, arg3, arg4 math.Int, arg5 uint64, arg6 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUnbondingDelegationWithOptions", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUnbondingDelegationWithOptions indicates an expected call of CancelUnbondingDelegationWithOptions.
+func (mr *MockModuleMockRecorder) CancelUnbondingDelegationWithOptions(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnbondingDelegationWithOptions", reflect.TypeOf((*MockModule)(nil).CancelUnbondingDelegationWithOptions), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // Delegate mocks base method.
 func (m *MockModule) Delegate(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +178,66 @@ func (mr *MockModuleMockRecorder) Delegate(arg0, arg1, arg2, arg3, arg4 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockModule)(nil).Delegate), arg0, arg1, arg2, arg3, arg4)
 }
 
+// DelegateWithOptions mocks base method.
+func (m *MockModule) DelegateWithOptions(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64, arg5 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateWithOptions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelegateWithOptions indicates an expected call of DelegateWithOptions.
+func (mr *MockModuleMockRecorder) DelegateWithOptions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateWithOptions", reflect.TypeOf((*MockModule)(nil).DelegateWithOptions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// EstimateGasForBlobs mocks base method.
+func (m *MockModule) EstimateGasForBlobs(arg0 context.Context, arg1 []*types2.Blob) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGasForBlobs", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGasForBlobs indicates an expected call of EstimateGasForBlobs.
+func (mr *MockModuleMockRecorder) EstimateGasForBlobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasForBlobs", reflect.TypeOf((*MockModule)(nil).EstimateGasForBlobs), arg0, arg1)
+}
+
+// GrantFee mocks base method.
+func (m *MockModule) GrantFee(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 math.Int, arg4 uint64, arg5 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantFee", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantFee indicates an expected call of GrantFee.
+func (mr *MockModuleMockRecorder) GrantFee(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantFee", reflect.TypeOf((*MockModule)(nil).GrantFee), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GrantPayForBlob mocks base method.
+func (m *MockModule) GrantPayForBlob(arg0 context.Context, arg1 types.AccAddress, arg2 math.Int, arg3 uint64, arg4 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPayForBlob", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantPayForBlob indicates an expected call of GrantPayForBlob.
+func (mr *MockModuleMockRecorder) GrantPayForBlob(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPayForBlob", reflect.TypeOf((*MockModule)(nil).GrantPayForBlob), arg0, arg1, arg2, arg3, arg4)
+}
+
 // IsStopped mocks base method.
 func (m *MockModule) IsStopped(arg0 context.Context) bool {
 	m.ctrl.T.Helper()
@@ -144,6 +252,21 @@ func (mr *MockModuleMockRecorder) IsStopped(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStopped", reflect.TypeOf((*MockModule)(nil).IsStopped), arg0)
 }
 
+// QueryAccount mocks base method.
+func (m *MockModule) QueryAccount(arg0 context.Context, arg1 types.AccAddress) (*state.AccountInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAccount", arg0, arg1)
+	ret0, _ := ret[0].(*state.AccountInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAccount indicates an expected call of QueryAccount.
+func (mr *MockModuleMockRecorder) QueryAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAccount", reflect.TypeOf((*MockModule)(nil).QueryAccount), arg0, arg1)
+}
+
 // QueryDelegation mocks base method.
 func (m *MockModule) QueryDelegation(arg0 context.Context, arg1 types.ValAddress) (*types0.QueryDelegationResponse, error) {
 	m.ctrl.T.Helper()
@@ -189,6 +312,51 @@ func (mr *MockModuleMockRecorder) QueryUnbonding(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryUnbonding", reflect.TypeOf((*MockModule)(nil).QueryUnbonding), arg0, arg1)
 }
 
+// QueryValidator mocks base method.
+func (m *MockModule) QueryValidator(arg0 context.Context, arg1 types.ValAddress) (*types0.QueryValidatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryValidator", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryValidatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryValidator indicates an expected call of QueryValidator.
+func (mr *MockModuleMockRecorder) QueryValidator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryValidator", reflect.TypeOf((*MockModule)(nil).QueryValidator), arg0, arg1)
+}
+
+// RevokeFee mocks base method.
+func (m *MockModule) RevokeFee(arg0 context.Context, arg1 types.AccAddress, arg2 math.Int, arg3 uint64, arg4 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFee", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeFee indicates an expected call of RevokeFee.
+func (mr *MockModuleMockRecorder) RevokeFee(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFee", reflect.TypeOf((*MockModule)(nil).RevokeFee), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RevokePayForBlob mocks base method.
+func (m *MockModule) RevokePayForBlob(arg0 context.Context, arg1 types.AccAddress, arg2 math.Int, arg3 uint64, arg4 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePayForBlob", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePayForBlob indicates an expected call of RevokePayForBlob.
+func (mr *MockModuleMockRecorder) RevokePayForBlob(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePayForBlob", reflect.TypeOf((*MockModule)(nil).RevokePayForBlob), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SubmitPayForData mocks base method.
 func (m *MockModule) SubmitPayForBlob(arg0 context.Context, arg1 namespace.ID, arg2 []byte, arg3 math.Int, arg4 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +372,51 @@ func (mr *MockModuleMockRecorder) SubmitPayForData(arg0, arg1, arg2, arg3, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlob", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlob), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SubmitPayForBlobWithOptions mocks base method.
+func (m *MockModule) SubmitPayForBlobWithOptions(arg0 context.Context, arg1 namespace.ID, arg2 []byte, arg3 math.Int, arg4 uint64, arg5 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitPayForBlobWithOptions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitPayForBlobWithOptions indicates an expected call of SubmitPayForBlobWithOptions.
+func (mr *MockModuleMockRecorder) SubmitPayForBlobWithOptions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlobWithOptions", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlobWithOptions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SubmitPayForBlobs mocks base method.
+func (m *MockModule) SubmitPayForBlobs(arg0 context.Context, arg1 math.Int, arg2 uint64, arg3 []*types2.Blob) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitPayForBlobs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitPayForBlobs indicates an expected call of SubmitPayForBlobs.
+func (mr *MockModuleMockRecorder) SubmitPayForBlobs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlobs", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlobs), arg0, arg1, arg2, arg3)
+}
+
+// SubmitPayForBlobsWithOptions mocks base method.
+func (m *MockModule) SubmitPayForBlobsWithOptions(arg0 context.Context, arg1 math.Int, arg2 uint64, arg3 []*types2.Blob, arg4 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitPayForBlobsWithOptions", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitPayForBlobsWithOptions indicates an expected call of SubmitPayForBlobsWithOptions.
+func (mr *MockModuleMockRecorder) SubmitPayForBlobsWithOptions(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitPayForBlobsWithOptions", reflect.TypeOf((*MockModule)(nil).SubmitPayForBlobsWithOptions), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SubmitTx mocks base method.
 func (m *MockModule) SubmitTx(arg0 context.Context, arg1 types1.Tx) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +447,51 @@ func (mr *MockModuleMockRecorder) Transfer(arg0, arg1, arg2, arg3, arg4 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockModule)(nil).Transfer), arg0, arg1, arg2, arg3, arg4)
 }
 
+// TransferWithOptions mocks base method.
+func (m *MockModule) TransferWithOptions(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 math.Int, arg4 uint64, arg5 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferWithOptions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferWithOptions indicates an expected call of TransferWithOptions.
+func (mr *MockModuleMockRecorder) TransferWithOptions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferWithOptions", reflect.TypeOf((*MockModule)(nil).TransferWithOptions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// TotalSupply mocks base method.
+func (m *MockModule) TotalSupply(arg0 context.Context) (*types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalSupply", arg0)
+	ret0, _ := ret[0].(*types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalSupply indicates an expected call of TotalSupply.
+func (mr *MockModuleMockRecorder) TotalSupply(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSupply", reflect.TypeOf((*MockModule)(nil).TotalSupply), arg0)
+}
+
+// TxStatus mocks base method.
+func (m *MockModule) TxStatus(arg0 context.Context, arg1 string) (*state.TxStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxStatus", arg0, arg1)
+	ret0, _ := ret[0].(*state.TxStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxStatus indicates an expected call of TxStatus.
+func (mr *MockModuleMockRecorder) TxStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxStatus", reflect.TypeOf((*MockModule)(nil).TxStatus), arg0, arg1)
+}
+
 // Undelegate mocks base method.
 func (m *MockModule) Undelegate(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -248,3 +506,18 @@ func (mr *MockModuleMockRecorder) Undelegate(arg0, arg1, arg2, arg3, arg4 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelegate", reflect.TypeOf((*MockModule)(nil).Undelegate), arg0, arg1, arg2, arg3, arg4)
 }
+
+// UndelegateWithOptions mocks base method.
+func (m *MockModule) UndelegateWithOptions(arg0 context.Context, arg1 types.ValAddress, arg2, arg3 math.Int, arg4 uint64, arg5 *state.TxOptions) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndelegateWithOptions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndelegateWithOptions indicates an expected call of UndelegateWithOptions.
+func (mr *MockModuleMockRecorder) UndelegateWithOptions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateWithOptions", reflect.TypeOf((*MockModule)(nil).UndelegateWithOptions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
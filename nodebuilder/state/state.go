@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/celestiaorg/nmt/namespace"
 
 	"github.com/celestiaorg/celestia-node/state"
@@ -34,13 +35,33 @@ type Module interface {
 	// `AppHash` is the result of applying the previous block's transaction list.
 	BalanceForAddress(ctx context.Context, addr state.Address) (*state.Balance, error)
 
+	// Accounts returns the keyring accounts the node can sign transactions with. The default account
+	// comes first.
+	Accounts(ctx context.Context) ([]state.Account, error)
+
+	// NOTE: the transactions below are signed with the node's default account. Their *WithOptions
+	// counterparts and the grants accept options, which may select another keyring account to sign
+	// with or the fee granter. Tokens may be restricted to sign with certain accounts only.
+
 	// Transfer sends the given amount of coins from default wallet of the node to the given account
 	// address.
 	Transfer(ctx context.Context, to state.AccAddress, amount, fee state.Int, gasLimit uint64) (*state.TxResponse, error)
+	// TransferWithOptions is Transfer customized with the given options.
+	TransferWithOptions(
+		ctx context.Context,
+		to state.AccAddress,
+		amount,
+		fee state.Int,
+		gasLimit uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
 	// SubmitTx submits the given transaction/message to the
 	// Celestia network and blocks until the tx is included in
 	// a block.
 	SubmitTx(ctx context.Context, tx state.Tx) (*state.TxResponse, error)
+	// TxStatus returns the status of the transaction submitted under the given hash. Transactions
+	// submitted by the node are tracked through resubmissions, so any of their hashes can be used.
+	TxStatus(ctx context.Context, hash string) (*state.TxStatus, error)
 	// SubmitPayForBlob builds, signs and submits a PayForBlob transaction.
 	SubmitPayForBlob(
 		ctx context.Context,
@@ -49,6 +70,37 @@ type Module interface {
 		fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// SubmitPayForBlobWithOptions is SubmitPayForBlob customized with the given options.
+	SubmitPayForBlobWithOptions(
+		ctx context.Context,
+		nID namespace.ID,
+		data []byte,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+	// SubmitPayForBlobs builds, signs and submits a single PayForBlob transaction paying for all the
+	// given blobs, which may be of different namespaces and share versions. Zero gasLim is estimated
+	// with EstimateGasForBlobs and zero fee is derived from the gas limit and the configured gas
+	// price.
+	SubmitPayForBlobs(
+		ctx context.Context,
+		fee state.Int,
+		gasLim uint64,
+		blobs []*apptypes.Blob,
+	) (*state.TxResponse, error)
+	// SubmitPayForBlobsWithOptions is SubmitPayForBlobs customized with the given options, which may
+	// set the fee granter paying the fee from the allowance granted with GrantFee.
+	SubmitPayForBlobsWithOptions(
+		ctx context.Context,
+		fee state.Int,
+		gasLim uint64,
+		blobs []*apptypes.Blob,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+	// EstimateGasForBlobs estimates the gas required to pay for the given blobs in a single
+	// PayForBlob transaction from the blob sizes and a simulation of the unsigned transaction.
+	EstimateGasForBlobs(ctx context.Context, blobs []*apptypes.Blob) (uint64, error)
 
 	// CancelUnbondingDelegation cancels a user's pending undelegation from a validator.
 	CancelUnbondingDelegation(
@@ -59,6 +111,17 @@ type Module interface {
 		fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// CancelUnbondingDelegationWithOptions is CancelUnbondingDelegation customized with the given
+	// options.
+	CancelUnbondingDelegationWithOptions(
+		ctx context.Context,
+		valAddr state.ValAddress,
+		amount,
+		height,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
 	// BeginRedelegate sends a user's delegated tokens to a new validator for redelegation.
 	BeginRedelegate(
 		ctx context.Context,
@@ -68,6 +131,16 @@ type Module interface {
 		fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// BeginRedelegateWithOptions is BeginRedelegate customized with the given options.
+	BeginRedelegateWithOptions(
+		ctx context.Context,
+		srcValAddr,
+		dstValAddr state.ValAddress,
+		amount,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
 	// Undelegate undelegates a user's delegated tokens, unbonding them from the current validator.
 	Undelegate(
 		ctx context.Context,
@@ -75,6 +148,14 @@ type Module interface {
 		amount, fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// UndelegateWithOptions is Undelegate customized with the given options.
+	UndelegateWithOptions(
+		ctx context.Context,
+		delAddr state.ValAddress,
+		amount, fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
 	// Delegate sends a user's liquid tokens to a validator for delegation.
 	Delegate(
 		ctx context.Context,
@@ -82,6 +163,55 @@ type Module interface {
 		amount, fee state.Int,
 		gasLim uint64,
 	) (*state.TxResponse, error)
+	// DelegateWithOptions is Delegate customized with the given options.
+	DelegateWithOptions(
+		ctx context.Context,
+		delAddr state.ValAddress,
+		amount, fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+
+	// GrantFee grants the grantee an allowance to pay the fees of its transactions from the account of
+	// the node selected by the options, up to the given spend limit. Zero spend limit does not limit
+	// the allowance, while negative one is rejected.
+	GrantFee(
+		ctx context.Context,
+		grantee state.AccAddress,
+		spendLimit,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+	// RevokeFee revokes the fee allowance granted to the grantee.
+	RevokeFee(
+		ctx context.Context,
+		grantee state.AccAddress,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+	// GrantPayForBlob authorizes the grantee to submit PayForBlob messages on behalf of the account of
+	// the node selected by the options. It requires the authz module to be enabled on the network.
+	GrantPayForBlob(
+		ctx context.Context,
+		grantee state.AccAddress,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+	// RevokePayForBlob revokes the authorization to submit PayForBlob messages granted to the
+	// grantee.
+	RevokePayForBlob(
+		ctx context.Context,
+		grantee state.AccAddress,
+		fee state.Int,
+		gasLim uint64,
+		opts *state.TxOptions,
+	) (*state.TxResponse, error)
+
+	// NOTE: the queries below are verified against the corresponding block's AppHash, the same way
+	// as BalanceForAddress.
 
 	// QueryDelegation retrieves the delegation information between a delegator and a validator.
 	QueryDelegation(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error)
@@ -93,6 +223,12 @@ type Module interface {
 		srcValAddr,
 		dstValAddr state.ValAddress,
 	) (*types.QueryRedelegationsResponse, error)
+	// QueryValidator retrieves the validator of the given operator address.
+	QueryValidator(ctx context.Context, valAddr state.ValAddress) (*types.QueryValidatorResponse, error)
+	// QueryAccount retrieves the on-chain information of the given account, such as its sequence.
+	QueryAccount(ctx context.Context, addr state.AccAddress) (*state.AccountInfo, error)
+	// TotalSupply retrieves the total supply of the Celestia coin.
+	TotalSupply(ctx context.Context) (*state.Balance, error)
 }
 
 // API is a wrapper around Module for the RPC.
@@ -100,6 +236,7 @@ type Module interface {
 type API struct {
 	Internal struct {
 		AccountAddress    func(ctx context.Context) (state.Address, error)                      `perm:"read"`
+		Accounts          func(ctx context.Context) ([]state.Account, error)                    `perm:"read"`
 		IsStopped         func(ctx context.Context) bool                                        `perm:"public"`
 		Balance           func(ctx context.Context) (*state.Balance, error)                     `perm:"read"`
 		BalanceForAddress func(ctx context.Context, addr state.Address) (*state.Balance, error) `perm:"public"`
@@ -110,7 +247,16 @@ type API struct {
 			fee state.Int,
 			gasLimit uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		TransferWithOptions func(
+			ctx context.Context,
+			to state.AccAddress,
+			amount,
+			fee state.Int,
+			gasLimit uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
 		SubmitTx         func(ctx context.Context, tx state.Tx) (*state.TxResponse, error) `perm:"write"`
+		TxStatus         func(ctx context.Context, hash string) (*state.TxStatus, error)   `perm:"read"`
 		SubmitPayForBlob func(
 			ctx context.Context,
 			nID namespace.ID,
@@ -118,6 +264,31 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		SubmitPayForBlobWithOptions func(
+			ctx context.Context,
+			nID namespace.ID,
+			data []byte,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		SubmitPayForBlobs func(
+			ctx context.Context,
+			fee state.Int,
+			gasLim uint64,
+			blobs []*apptypes.Blob,
+		) (*state.TxResponse, error) `perm:"write"`
+		SubmitPayForBlobsWithOptions func(
+			ctx context.Context,
+			fee state.Int,
+			gasLim uint64,
+			blobs []*apptypes.Blob,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		EstimateGasForBlobs func(
+			ctx context.Context,
+			blobs []*apptypes.Blob,
+		) (uint64, error) `perm:"read"`
 		CancelUnbondingDelegation func(
 			ctx context.Context,
 			valAddr state.ValAddress,
@@ -126,6 +297,15 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		CancelUnbondingDelegationWithOptions func(
+			ctx context.Context,
+			valAddr state.ValAddress,
+			amount,
+			height,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
 		BeginRedelegate func(
 			ctx context.Context,
 			srcValAddr,
@@ -134,6 +314,15 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		BeginRedelegateWithOptions func(
+			ctx context.Context,
+			srcValAddr,
+			dstValAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
 		Undelegate func(
 			ctx context.Context,
 			delAddr state.ValAddress,
@@ -141,6 +330,14 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		UndelegateWithOptions func(
+			ctx context.Context,
+			delAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
 		Delegate func(
 			ctx context.Context,
 			delAddr state.ValAddress,
@@ -148,6 +345,43 @@ type API struct {
 			fee state.Int,
 			gasLim uint64,
 		) (*state.TxResponse, error) `perm:"write"`
+		DelegateWithOptions func(
+			ctx context.Context,
+			delAddr state.ValAddress,
+			amount,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		GrantFee func(
+			ctx context.Context,
+			grantee state.AccAddress,
+			spendLimit,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		RevokeFee func(
+			ctx context.Context,
+			grantee state.AccAddress,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		GrantPayForBlob func(
+			ctx context.Context,
+			grantee state.AccAddress,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
+		RevokePayForBlob func(
+			ctx context.Context,
+			grantee state.AccAddress,
+			fee state.Int,
+			gasLim uint64,
+			opts *state.TxOptions,
+		) (*state.TxResponse, error) `perm:"write"`
 		QueryDelegation func(
 			ctx context.Context,
 			valAddr state.ValAddress,
@@ -161,9 +395,19 @@ type API struct {
 			srcValAddr,
 			dstValAddr state.ValAddress,
 		) (*types.QueryRedelegationsResponse, error) `perm:"public"`
+		QueryValidator func(
+			ctx context.Context,
+			valAddr state.ValAddress,
+		) (*types.QueryValidatorResponse, error) `perm:"public"`
+		QueryAccount func(ctx context.Context, addr state.AccAddress) (*state.AccountInfo, error) `perm:"public"`
+		TotalSupply  func(ctx context.Context) (*state.Balance, error)                            `perm:"public"`
 	}
 }
 
+func (api *API) Accounts(ctx context.Context) ([]state.Account, error) {
+	return api.Internal.Accounts(ctx)
+}
+
 func (api *API) AccountAddress(ctx context.Context) (state.Address, error) {
 	return api.Internal.AccountAddress(ctx)
 }
@@ -186,10 +430,25 @@ func (api *API) Transfer(
 	return api.Internal.Transfer(ctx, to, amount, fee, gasLimit)
 }
 
+func (api *API) TransferWithOptions(
+	ctx context.Context,
+	to state.AccAddress,
+	amount,
+	fee state.Int,
+	gasLimit uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.TransferWithOptions(ctx, to, amount, fee, gasLimit, opts)
+}
+
 func (api *API) SubmitTx(ctx context.Context, tx state.Tx) (*state.TxResponse, error) {
 	return api.Internal.SubmitTx(ctx, tx)
 }
 
+func (api *API) TxStatus(ctx context.Context, hash string) (*state.TxStatus, error) {
+	return api.Internal.TxStatus(ctx, hash)
+}
+
 func (api *API) SubmitPayForBlob(
 	ctx context.Context,
 	nID namespace.ID,
@@ -200,6 +459,40 @@ func (api *API) SubmitPayForBlob(
 	return api.Internal.SubmitPayForBlob(ctx, nID, data, fee, gasLim)
 }
 
+func (api *API) SubmitPayForBlobWithOptions(
+	ctx context.Context,
+	nID namespace.ID,
+	data []byte,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.SubmitPayForBlobWithOptions(ctx, nID, data, fee, gasLim, opts)
+}
+
+func (api *API) SubmitPayForBlobs(
+	ctx context.Context,
+	fee state.Int,
+	gasLim uint64,
+	blobs []*apptypes.Blob,
+) (*state.TxResponse, error) {
+	return api.Internal.SubmitPayForBlobs(ctx, fee, gasLim, blobs)
+}
+
+func (api *API) SubmitPayForBlobsWithOptions(
+	ctx context.Context,
+	fee state.Int,
+	gasLim uint64,
+	blobs []*apptypes.Blob,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.SubmitPayForBlobsWithOptions(ctx, fee, gasLim, blobs, opts)
+}
+
+func (api *API) EstimateGasForBlobs(ctx context.Context, blobs []*apptypes.Blob) (uint64, error) {
+	return api.Internal.EstimateGasForBlobs(ctx, blobs)
+}
+
 func (api *API) CancelUnbondingDelegation(
 	ctx context.Context,
 	valAddr state.ValAddress,
@@ -211,6 +504,18 @@ func (api *API) CancelUnbondingDelegation(
 	return api.Internal.CancelUnbondingDelegation(ctx, valAddr, amount, height, fee, gasLim)
 }
 
+func (api *API) CancelUnbondingDelegationWithOptions(
+	ctx context.Context,
+	valAddr state.ValAddress,
+	amount,
+	height,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.CancelUnbondingDelegationWithOptions(ctx, valAddr, amount, height, fee, gasLim, opts)
+}
+
 func (api *API) BeginRedelegate(
 	ctx context.Context,
 	srcValAddr, dstValAddr state.ValAddress,
@@ -221,6 +526,17 @@ func (api *API) BeginRedelegate(
 	return api.Internal.BeginRedelegate(ctx, srcValAddr, dstValAddr, amount, fee, gasLim)
 }
 
+func (api *API) BeginRedelegateWithOptions(
+	ctx context.Context,
+	srcValAddr, dstValAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.BeginRedelegateWithOptions(ctx, srcValAddr, dstValAddr, amount, fee, gasLim, opts)
+}
+
 func (api *API) Undelegate(
 	ctx context.Context,
 	delAddr state.ValAddress,
@@ -231,6 +547,17 @@ func (api *API) Undelegate(
 	return api.Internal.Undelegate(ctx, delAddr, amount, fee, gasLim)
 }
 
+func (api *API) UndelegateWithOptions(
+	ctx context.Context,
+	delAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.UndelegateWithOptions(ctx, delAddr, amount, fee, gasLim, opts)
+}
+
 func (api *API) Delegate(
 	ctx context.Context,
 	delAddr state.ValAddress,
@@ -241,6 +568,58 @@ func (api *API) Delegate(
 	return api.Internal.Delegate(ctx, delAddr, amount, fee, gasLim)
 }
 
+func (api *API) DelegateWithOptions(
+	ctx context.Context,
+	delAddr state.ValAddress,
+	amount,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.DelegateWithOptions(ctx, delAddr, amount, fee, gasLim, opts)
+}
+
+func (api *API) GrantFee(
+	ctx context.Context,
+	grantee state.AccAddress,
+	spendLimit,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.GrantFee(ctx, grantee, spendLimit, fee, gasLim, opts)
+}
+
+func (api *API) RevokeFee(
+	ctx context.Context,
+	grantee state.AccAddress,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.RevokeFee(ctx, grantee, fee, gasLim, opts)
+}
+
+func (api *API) GrantPayForBlob(
+	ctx context.Context,
+	grantee state.AccAddress,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.GrantPayForBlob(ctx, grantee, fee, gasLim, opts)
+}
+
+func (api *API) RevokePayForBlob(
+	ctx context.Context,
+	grantee state.AccAddress,
+	fee state.Int,
+	gasLim uint64,
+	opts *state.TxOptions,
+) (*state.TxResponse, error) {
+	return api.Internal.RevokePayForBlob(ctx, grantee, fee, gasLim, opts)
+}
+
 func (api *API) QueryDelegation(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error) {
 	return api.Internal.QueryDelegation(ctx, valAddr)
 }
@@ -259,6 +638,18 @@ func (api *API) QueryRedelegations(
 	return api.Internal.QueryRedelegations(ctx, srcValAddr, dstValAddr)
 }
 
+func (api *API) QueryValidator(ctx context.Context, valAddr state.ValAddress) (*types.QueryValidatorResponse, error) {
+	return api.Internal.QueryValidator(ctx, valAddr)
+}
+
+func (api *API) QueryAccount(ctx context.Context, addr state.AccAddress) (*state.AccountInfo, error) {
+	return api.Internal.QueryAccount(ctx, addr)
+}
+
+func (api *API) TotalSupply(ctx context.Context) (*state.Balance, error) {
+	return api.Internal.TotalSupply(ctx)
+}
+
 func (api *API) Balance(ctx context.Context) (*state.Balance, error) {
 	return api.Internal.Balance(ctx)
 }
@@ -3,6 +3,7 @@ package share
 import (
 	"context"
 	"errors"
+	"time"
 
 	da "github.com/celestiaorg/celestia-app/pkg/da"
 )
@@ -24,3 +25,40 @@ type Availability interface {
 	// TODO(@Wondertan): Merge with SharesAvailable method, eventually
 	ProbabilityOfAvailability(context.Context) float64
 }
+
+// ReportingAvailability is implemented by the Availabilities able to report the outcome of every
+// sample taken.
+type ReportingAvailability interface {
+	Availability
+	// SharesAvailableWithResult is the same as SharesAvailable, but additionally returns the
+	// SamplingResult describing every sample taken. The result is returned on failures as well.
+	SharesAvailableWithResult(context.Context, *Root) (*SamplingResult, error)
+}
+
+// SamplingResult describes the samples taken to validate availability of a Root.
+type SamplingResult struct {
+	Samples []SampleResult `json:"samples"`
+}
+
+// SampleResult describes the outcome of retrieving a single sample.
+type SampleResult struct {
+	Coordinate Coordinate `json:"coordinate"`
+	Success    bool       `json:"success"`
+	// Latency is the time it took to retrieve the sample or to fail.
+	Latency time.Duration `json:"latency"`
+	// Source is the source that served the sample, e.g. the ID of the serving peer. Empty if
+	// unknown.
+	Source string `json:"source,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Failed returns the results of the samples that could not be retrieved.
+func (r *SamplingResult) Failed() []SampleResult {
+	var failed []SampleResult
+	for _, s := range r.Samples {
+		if !s.Success {
+			failed = append(failed, s)
+		}
+	}
+	return failed
+}
@@ -3,7 +3,10 @@ package cache
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/autobatch"
@@ -21,6 +24,9 @@ var (
 
 	cacheAvailabilityPrefix = datastore.NewKey("sampling_result")
 	writeBatchSize          = 2048
+
+	// maxSampledRange is the maximum amount of heights that can be requested with SampledRange.
+	maxSampledRange uint64 = 4096
 )
 
 // ShareAvailability wraps a given share.Availability (whether it's light or full)
@@ -50,34 +56,127 @@ func NewShareAvailability(
 	}
 }
 
-// SharesAvailable will store, upon success, the hash of the given Root to disk.
+// SharesAvailable will store, upon success, the SamplingRecord of the given Root to disk. If the
+// context was wrapped with WithHeight, the record is additionally indexed by the height.
 func (ca *ShareAvailability) SharesAvailable(ctx context.Context, root *share.Root) error {
+	_, err := ca.SharesAvailableWithResult(ctx, root)
+	return err
+}
+
+// SharesAvailableWithResult is the same as SharesAvailable, but additionally returns the
+// share.SamplingResult reported by the wrapped share.Availability. The result is empty, if the
+// wrapped share.Availability does not report it or if the Root was already sampled. Roots sampled
+// before are sampled again if the context was wrapped with WithResample.
+func (ca *ShareAvailability) SharesAvailableWithResult(
+	ctx context.Context,
+	root *share.Root,
+) (*share.SamplingResult, error) {
+	key := rootKey(root)
+	height := heightFromContext(ctx)
 	// short-circuit if the given root is minimum DAH of an empty data square
 	if isMinRoot(root) {
-		return nil
+		return &share.SamplingResult{}, ca.index(ctx, key, root, height, true)
 	}
-	// do not sample over Root that has already been sampled
-	key := rootKey(root)
-
-	ca.dsLk.RLock()
-	exists, err := ca.ds.Has(ctx, key)
-	ca.dsLk.RUnlock()
-	if err != nil || exists {
-		return err
+	// do not sample over Root that has already been sampled, unless requested explicitly
+	if !resampleFromContext(ctx) {
+		ca.dsLk.RLock()
+		exists, err := ca.ds.Has(ctx, key)
+		ca.dsLk.RUnlock()
+		if err != nil {
+			return &share.SamplingResult{}, err
+		}
+		if exists {
+			return &share.SamplingResult{}, ca.index(ctx, key, root, height, false)
+		}
 	}
 
-	err = ca.avail.SharesAvailable(ctx, root)
+	result, err := ca.sharesAvailable(ctx, root)
 	if err != nil {
-		return err
+		return result, err
+	}
+
+	record := &SamplingRecord{
+		Height:      height,
+		Root:        root.Hash(),
+		Timestamp:   time.Now(),
+		SampleCount: ca.sampleCount(root, result),
 	}
 
 	ca.dsLk.Lock()
-	err = ca.ds.Put(ctx, key, []byte{})
+	err = ca.put(ctx, key, record)
 	ca.dsLk.Unlock()
 	if err != nil {
 		log.Errorw("storing root of successful SharesAvailable request to disk", "err", err)
 	}
-	return err
+	return result, err
+}
+
+// SampledRange returns the SamplingRecords of successfully sampled Roots for the heights within
+// the given inclusive range. Heights that were not sampled are omitted.
+func (ca *ShareAvailability) SampledRange(ctx context.Context, from, to uint64) ([]*SamplingRecord, error) {
+	if from == 0 || from > to {
+		return nil, fmt.Errorf("share/cache: invalid range [%d:%d]", from, to)
+	}
+	if to-from+1 > maxSampledRange {
+		return nil, fmt.Errorf("share/cache: range [%d:%d] exceeds the maximum of %d heights",
+			from, to, maxSampledRange)
+	}
+
+	ca.dsLk.RLock()
+	defer ca.dsLk.RUnlock()
+
+	records := make([]*SamplingRecord, 0)
+	for height := from; height <= to; height++ {
+		key, err := ca.ds.Get(ctx, heightIndexKey(height))
+		if errors.Is(err, datastore.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ca.ds.Get(ctx, datastore.RawKey(string(key)))
+		if err != nil {
+			return nil, fmt.Errorf("share/cache: getting record for height %d: %w", height, err)
+		}
+		record, err := unmarshalRecord(data)
+		if err != nil {
+			return nil, fmt.Errorf("share/cache: unmarshalling record for height %d: %w", height, err)
+		}
+		// the same Root may be indexed by multiple heights, while its record keeps only one of them
+		record.Height = height
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Prune removes the SamplingRecord of the given Root sampled at the given height. The record is
+// kept if it was indexed by another height, as the same Root may belong to multiple headers.
+func (ca *ShareAvailability) Prune(ctx context.Context, height uint64, root *share.Root) error {
+	ca.dsLk.Lock()
+	defer ca.dsLk.Unlock()
+
+	err := ca.ds.Delete(ctx, heightIndexKey(height))
+	if err != nil {
+		return err
+	}
+
+	key := rootKey(root)
+	data, err := ca.ds.Get(ctx, key)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	record, err := unmarshalRecord(data)
+	if err != nil {
+		return fmt.Errorf("share/cache: unmarshalling record for height %d: %w", height, err)
+	}
+	if record.Height != 0 && record.Height != height {
+		return nil
+	}
+	return ca.ds.Delete(ctx, key)
 }
 
 func (ca *ShareAvailability) ProbabilityOfAvailability(ctx context.Context) float64 {
@@ -89,6 +188,81 @@ func (ca *ShareAvailability) Close(ctx context.Context) error {
 	return ca.ds.Flush(ctx)
 }
 
+// put stores the record under the root key and indexes it by height, if known.
+func (ca *ShareAvailability) put(ctx context.Context, key datastore.Key, record *SamplingRecord) error {
+	data, err := record.marshal()
+	if err != nil {
+		return err
+	}
+	err = ca.ds.Put(ctx, key, data)
+	if err != nil || record.Height == 0 {
+		return err
+	}
+	return ca.ds.Put(ctx, heightIndexKey(record.Height), key.Bytes())
+}
+
+// index indexes the record of an already available Root by the given height, if known. The
+// record keeps the highest height it was indexed by, so that Prune, going from lower to higher
+// heights, removes it only together with the last height referencing it. Records of empty Roots
+// are created on demand, as those are never sampled.
+func (ca *ShareAvailability) index(
+	ctx context.Context,
+	key datastore.Key,
+	root *share.Root,
+	height uint64,
+	empty bool,
+) error {
+	if height == 0 {
+		return nil
+	}
+
+	ca.dsLk.Lock()
+	defer ca.dsLk.Unlock()
+
+	record := &SamplingRecord{Root: root.Hash(), Timestamp: time.Now()}
+	data, err := ca.ds.Get(ctx, key)
+	switch {
+	case err == nil:
+		record, err = unmarshalRecord(data)
+		if err != nil {
+			return fmt.Errorf("share/cache: unmarshalling record for height %d: %w", height, err)
+		}
+		if record.Root == nil {
+			record.Root = root.Hash()
+		}
+	case !errors.Is(err, datastore.ErrNotFound):
+		return err
+	case !empty:
+		// the record was pruned in the meantime
+		return nil
+	}
+
+	if record.Height >= height {
+		return ca.ds.Put(ctx, heightIndexKey(height), key.Bytes())
+	}
+	record.Height = height
+	return ca.put(ctx, key, record)
+}
+
+func (ca *ShareAvailability) sharesAvailable(ctx context.Context, root *share.Root) (*share.SamplingResult, error) {
+	if ra, ok := ca.avail.(share.ReportingAvailability); ok {
+		return ra.SharesAvailableWithResult(ctx, root)
+	}
+	return &share.SamplingResult{}, ca.avail.SharesAvailable(ctx, root)
+}
+
+// sampleCount prefers the amount of samples actually reported over the amount the wrapped
+// share.Availability is configured to take.
+func (ca *ShareAvailability) sampleCount(root *share.Root, result *share.SamplingResult) int {
+	if len(result.Samples) > 0 {
+		return len(result.Samples) - len(result.Failed())
+	}
+	if sc, ok := ca.avail.(sampleCounter); ok {
+		return sc.SampleCount(root)
+	}
+	return 0
+}
+
 func rootKey(root *share.Root) datastore.Key {
 	return datastore.NewKey(root.String())
 }
@@ -103,6 +103,108 @@ func TestCacheAvailability_NoDuplicateSampling(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestCacheAvailability_SampledRange tests that successful sampling results are indexed by height
+// and can be queried by range.
+func TestCacheAvailability_SampledRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	root := availability_test.RandFillBS(t, 16, mdutils.Bserv())
+	ds := sync.MutexWrap(datastore.NewMapDatastore())
+	ca := NewShareAvailability(&dummyAvailability{}, ds)
+
+	err := ca.SharesAvailable(WithHeight(ctx, 5), root)
+	require.NoError(t, err)
+
+	records, err := ca.SampledRange(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.EqualValues(t, 5, records[0].Height)
+	assert.Equal(t, root.Hash(), records[0].Root)
+	assert.False(t, records[0].Timestamp.IsZero())
+
+	records, err = ca.SampledRange(ctx, 6, 10)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	_, err = ca.SampledRange(ctx, 10, 1)
+	require.Error(t, err)
+	_, err = ca.SampledRange(ctx, 1, maxSampledRange+1)
+	require.Error(t, err)
+}
+
+// TestCacheAvailability_SampledRangeCached tests that heights of empty blocks and of Roots that
+// were already sampled under another height are indexed as well.
+func TestCacheAvailability_SampledRangeCached(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	root := availability_test.RandFillBS(t, 16, mdutils.Bserv())
+	minDAH := da.MinDataAvailabilityHeader()
+	ds := sync.MutexWrap(datastore.NewMapDatastore())
+	// dummyAvailability fails on duplicate sampling, so the repeated root must be served from cache
+	ca := NewShareAvailability(&dummyAvailability{}, ds)
+
+	err := ca.SharesAvailable(WithHeight(ctx, 1), root)
+	require.NoError(t, err)
+	err = ca.SharesAvailable(WithHeight(ctx, 2), &minDAH)
+	require.NoError(t, err)
+	err = ca.SharesAvailable(WithHeight(ctx, 3), root)
+	require.NoError(t, err)
+	err = ca.SharesAvailable(WithHeight(ctx, 4), &minDAH)
+	require.NoError(t, err)
+
+	records, err := ca.SampledRange(ctx, 1, 4)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	for i, record := range records {
+		assert.EqualValues(t, i+1, record.Height)
+	}
+	assert.Equal(t, root.Hash(), records[0].Root)
+	assert.Equal(t, minDAH.Hash(), records[1].Root)
+	assert.Equal(t, root.Hash(), records[2].Root)
+	assert.Equal(t, minDAH.Hash(), records[3].Root)
+
+	// pruning the first height keeps the root for the later one
+	err = ca.Prune(ctx, 1, root)
+	require.NoError(t, err)
+	records, err = ca.SampledRange(ctx, 1, 4)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.EqualValues(t, 3, records[1].Height)
+	assert.Equal(t, root.Hash(), records[1].Root)
+}
+
+// TestCacheAvailability_Prune tests that pruning removes the record only for the height it was
+// sampled at.
+func TestCacheAvailability_Prune(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	root := availability_test.RandFillBS(t, 16, mdutils.Bserv())
+	ds := sync.MutexWrap(datastore.NewMapDatastore())
+	ca := NewShareAvailability(&dummyAvailability{}, ds)
+
+	err := ca.SharesAvailable(WithHeight(ctx, 5), root)
+	require.NoError(t, err)
+
+	// the record of another height is kept
+	err = ca.Prune(ctx, 4, root)
+	require.NoError(t, err)
+	has, err := ca.ds.Has(ctx, rootKey(root))
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	err = ca.Prune(ctx, 5, root)
+	require.NoError(t, err)
+	has, err = ca.ds.Has(ctx, rootKey(root))
+	require.NoError(t, err)
+	assert.False(t, has)
+	records, err := ca.SampledRange(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
 // TestCacheAvailability_MinRoot tests to make sure `SharesAvailable` will
 // short circuit if the given root is a minimum DataAvailabilityHeader (minRoot).
 func TestCacheAvailability_MinRoot(t *testing.T) {
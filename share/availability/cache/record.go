@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+var heightIndexPrefix = datastore.NewKey("height")
+
+// SamplingRecord describes a successful sampling of a Root.
+type SamplingRecord struct {
+	// Height of the header the sampled Root belongs to. Zero if it was not known at the time of
+	// sampling.
+	Height uint64 `json:"height"`
+	// Root is the hash of the sampled Root.
+	Root []byte `json:"root"`
+	// Timestamp is the time the sampling succeeded.
+	Timestamp time.Time `json:"timestamp"`
+	// SampleCount is the amount of shares sampled over the Root.
+	SampleCount int `json:"sample_count"`
+}
+
+// sampleCounter is implemented by the share.Availability that can report the amount of samples
+// it takes over a Root.
+type sampleCounter interface {
+	SampleCount(*share.Root) int
+}
+
+type heightKey struct{}
+
+// WithHeight attaches the height of the header the Root belongs to to the context, so that
+// ShareAvailability indexes the sampling result by it.
+func WithHeight(ctx context.Context, height uint64) context.Context {
+	return context.WithValue(ctx, heightKey{}, height)
+}
+
+type resampleKey struct{}
+
+// WithResample indicates to ShareAvailability that the Root must be sampled again, even if it was
+// successfully sampled before.
+func WithResample(ctx context.Context) context.Context {
+	return context.WithValue(ctx, resampleKey{}, true)
+}
+
+func resampleFromContext(ctx context.Context) bool {
+	resample, _ := ctx.Value(resampleKey{}).(bool)
+	return resample
+}
+
+func heightFromContext(ctx context.Context) uint64 {
+	height, _ := ctx.Value(heightKey{}).(uint64)
+	return height
+}
+
+func heightIndexKey(height uint64) datastore.Key {
+	return heightIndexPrefix.ChildString(strconv.FormatUint(height, 10))
+}
+
+func (r *SamplingRecord) marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func unmarshalRecord(data []byte) (*SamplingRecord, error) {
+	r := &SamplingRecord{}
+	// roots sampled before records were introduced are stored with an empty value
+	if len(data) == 0 {
+		return r, nil
+	}
+	return r, json.Unmarshal(data, r)
+}
@@ -18,8 +18,12 @@ import (
 var log = logging.Logger("share/discovery")
 
 const (
-	// rendezvousPoint is the namespace where peers advertise and discover each other.
-	rendezvousPoint = "full"
+	// FullNodesTag is the tag of the rendezvous point full nodes advertise themselves under and are
+	// discovered by.
+	FullNodesTag = "full"
+	// ArchivalNodesTag is the tag of the rendezvous point full nodes keeping the whole history of
+	// the chain (i.e. not pruning it) advertise themselves under and are discovered by.
+	ArchivalNodesTag = "archival"
 
 	// eventbusBufSize is the size of the buffered channel to handle
 	// events in libp2p. We specify a larger buffer size for the channel
@@ -41,6 +45,8 @@ var discoveryRetryTimeout = defaultRetryTimeout
 // Discovery combines advertise and discover services and allows to store discovered nodes.
 // TODO: The code here gets horribly hairy, so we should refactor this at some point
 type Discovery struct {
+	// tag is the rendezvous point peers advertise and discover each other under
+	tag       string
 	set       *limitedSet
 	host      host.Host
 	disc      discovery.Discovery
@@ -57,10 +63,11 @@ type Discovery struct {
 
 type OnUpdatedPeers func(peerID peer.ID, isAdded bool)
 
-// NewDiscovery constructs a new discovery.
+// NewDiscovery constructs a new discovery advertising and discovering peers under the given tag.
 func NewDiscovery(
 	h host.Host,
 	d discovery.Discovery,
+	tag string,
 	opts ...Option,
 ) *Discovery {
 	params := DefaultParameters()
@@ -70,6 +77,7 @@ func NewDiscovery(
 	}
 
 	return &Discovery{
+		tag:            tag,
 		set:            newLimitedSet(params.PeersLimit),
 		host:           h,
 		disc:           d,
@@ -114,7 +122,7 @@ func (d *Discovery) WithOnPeersUpdate(f OnUpdatedPeers) {
 	}
 }
 
-// Peers provides a list of discovered peers in the "full" topic.
+// Peers provides a list of discovered peers under the Discovery's tag.
 // If Discovery hasn't found any peers, it blocks until at least one peer is found.
 func (d *Discovery) Peers(ctx context.Context) ([]peer.ID, error) {
 	return d.set.Peers(ctx)
@@ -131,12 +139,12 @@ func (d *Discovery) Advertise(ctx context.Context) {
 	timer := time.NewTimer(d.params.AdvertiseInterval)
 	defer timer.Stop()
 	for {
-		_, err := d.disc.Advertise(ctx, rendezvousPoint)
+		_, err := d.disc.Advertise(ctx, d.tag)
 		if err != nil {
 			if ctx.Err() != nil {
 				return
 			}
-			log.Warn("error advertising %s: %s", rendezvousPoint, err.Error())
+			log.Warnf("error advertising %s: %s", d.tag, err.Error())
 
 			errTimer := time.NewTimer(time.Minute)
 			select {
@@ -212,7 +220,7 @@ func (d *Discovery) disconnectsLoop(ctx context.Context, sub event.Subscription)
 					continue
 				}
 
-				d.host.ConnManager().Unprotect(evnt.Peer, rendezvousPoint)
+				d.host.ConnManager().Unprotect(evnt.Peer, d.tag)
 				d.connector.Backoff(evnt.Peer)
 				d.set.Remove(evnt.Peer)
 				d.onUpdatedPeers(evnt.Peer, false)
@@ -254,7 +262,7 @@ func (d *Discovery) discover(ctx context.Context) bool {
 		findCancel()
 	}()
 
-	peers, err := d.disc.FindPeers(findCtx, rendezvousPoint)
+	peers, err := d.disc.FindPeers(findCtx, d.tag)
 	if err != nil {
 		log.Error("unable to start discovery", "err", err)
 		return false
@@ -348,7 +356,7 @@ func (d *Discovery) handleDiscoveredPeer(ctx context.Context, peer peer.AddrInfo
 	// NOTE: This is does not protect from remote killing the connection.
 	//  In the future, we should design a protocol that keeps bidirectional agreement on whether
 	//  connection should be kept or not, similar to mesh link in GossipSub.
-	d.host.ConnManager().Protect(peer.ID, rendezvousPoint)
+	d.host.ConnManager().Protect(peer.ID, d.tag)
 	return true
 }
 
@@ -97,7 +97,7 @@ func newTestnet(ctx context.Context, t *testing.T) *testnet {
 
 func (t *testnet) discovery(opts ...Option) *Discovery {
 	hst, routingDisc := t.peer()
-	disc := NewDiscovery(hst, routingDisc, opts...)
+	disc := NewDiscovery(hst, routingDisc, FullNodesTag, opts...)
 	err := disc.Start(t.ctx)
 	require.NoError(t.T, err)
 	t.T.Cleanup(func() {
@@ -3,6 +3,7 @@ package full
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	ipldFormat "github.com/ipfs/go-ipld-format"
 	logging "github.com/ipfs/go-log/v2"
@@ -22,20 +23,27 @@ type ShareAvailability struct {
 	store  *eds.Store
 	getter share.Getter
 	disc   *discovery.Discovery
+	// archival is used to advertise the node as the one keeping the whole history. It is nil for
+	// the nodes pruning the history.
+	archival *discovery.Discovery
 
 	cancel context.CancelFunc
 }
 
 // NewShareAvailability creates a new full ShareAvailability.
+// The archival Discovery is optional and should be given only to the nodes that keep the whole
+// history.
 func NewShareAvailability(
 	store *eds.Store,
 	getter share.Getter,
 	disc *discovery.Discovery,
+	archival *discovery.Discovery,
 ) *ShareAvailability {
 	return &ShareAvailability{
-		store:  store,
-		getter: getter,
-		disc:   disc,
+		store:    store,
+		getter:   getter,
+		disc:     disc,
+		archival: archival,
 	}
 }
 
@@ -44,6 +52,9 @@ func (fa *ShareAvailability) Start(context.Context) error {
 	fa.cancel = cancel
 
 	go fa.disc.Advertise(ctx)
+	if fa.archival != nil {
+		go fa.archival.Advertise(ctx)
+	}
 	return nil
 }
 
@@ -74,9 +85,13 @@ func (fa *ShareAvailability) SharesAvailable(ctx context.Context, root *share.Ro
 	if err != nil {
 		log.Errorw("availability validation failed", "root", root.String(), "err", err.Error())
 		var byzantineErr *byzantine.ErrByzantine
-		if ipldFormat.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &byzantineErr) {
+		if ipldFormat.IsNotFound(err) {
 			return share.ErrNotAvailable
 		}
+		// keep the timeout distinguishable from the data confirmed to be missing
+		if errors.Is(err, context.DeadlineExceeded) && !errors.As(err, &byzantineErr) {
+			return fmt.Errorf("%w: %w", share.ErrNotAvailable, err)
+		}
 
 		return err
 	}
@@ -40,8 +40,9 @@ func TestAvailability(getter share.Getter) *ShareAvailability {
 	disc := discovery.NewDiscovery(
 		nil,
 		routing.NewRoutingDiscovery(routinghelpers.Null{}),
+		discovery.FullNodesTag,
 		discovery.WithAdvertiseInterval(time.Second),
 		discovery.WithPeersLimit(10),
 	)
-	return NewShareAvailability(nil, getter, disc)
+	return NewShareAvailability(nil, getter, disc, nil)
 }
@@ -3,7 +3,9 @@ package light
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
+	"time"
 
 	ipldFormat "github.com/ipfs/go-ipld-format"
 	logging "github.com/ipfs/go-log/v2"
@@ -14,6 +16,10 @@ import (
 
 var log = logging.Logger("share/light")
 
+// errMissingSample is reported for a sample whose share is missing from the batch returned by
+// share.SampleGetter.
+var errMissingSample = errors.New("sample is missing from the batch")
+
 // ShareAvailability implements share.Availability using Data Availability Sampling technique.
 // It is light because it does not require the downloading of all the data to verify
 // its availability. It is assumed that there are a lot of lightAvailability instances
@@ -38,8 +44,19 @@ func NewShareAvailability(
 }
 
 // SharesAvailable randomly samples `params.SampleAmount` amount of Shares committed to the given
-// Root. This way SharesAvailable subjectively verifies that Shares are available.
+// Root, or the amount derived from the square width if `params.Confidence` is set. This way
+// SharesAvailable subjectively verifies that Shares are available.
 func (la *ShareAvailability) SharesAvailable(ctx context.Context, dah *share.Root) error {
+	_, err := la.SharesAvailableWithResult(ctx, dah)
+	return err
+}
+
+// SharesAvailableWithResult performs the same sampling as SharesAvailable and reports the outcome
+// of every sample taken. Samples still in progress when the sampling fails are not reported.
+func (la *ShareAvailability) SharesAvailableWithResult(
+	ctx context.Context,
+	dah *share.Root,
+) (*share.SamplingResult, error) {
 	log.Debugw("Validate availability", "root", dah.String())
 	// We assume the caller of this method has already performed basic validation on the
 	// given dah/root. If for some reason this has not happened, the node should panic.
@@ -48,9 +65,9 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, dah *share.Roo
 			"err", err)
 		panic(err)
 	}
-	samples, err := SampleSquare(len(dah.RowsRoots), int(la.params.SampleAmount))
+	samples, err := SampleSquare(len(dah.RowsRoots), la.params.sampleAmount(len(dah.RowsRoots)))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// indicate to the share.Getter that a blockservice session should be created. This
@@ -58,51 +75,229 @@ func (la *ShareAvailability) SharesAvailable(ctx context.Context, dah *share.Roo
 	ctx = getters.WithSession(ctx)
 
 	log.Debugw("starting sampling session", "root", dah.String())
-	errs := make(chan error, len(samples))
+	if sg, ok := la.getter.(share.SampleGetter); ok {
+		result, err := la.sampleBatch(ctx, sg, dah, samples)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return result, availabilityError(dah, err)
+		}
+		// the failure of the batch cannot be attributed to particular samples, so they are
+		// requested one by one to find out which of them cannot be retrieved. In the adaptive
+		// mode, the ones timing out are then replaced with extra samples.
+		log.Debugw("sampling batch failed, requesting samples one by one", "root", dah.String(), "err", err)
+	}
+	if la.params.MaxExtraSamples > 0 {
+		return la.sampleAdaptive(ctx, dah, samples)
+	}
+
+	results := make(chan sampleResult, len(samples))
 	for _, s := range samples {
 		go func(s Sample) {
 			log.Debugw("fetching share", "root", dah.String(), "row", s.Row, "col", s.Col)
-			_, err := la.getter.GetShare(ctx, dah, s.Row, s.Col)
-			if err != nil {
+			res := la.fetchSample(ctx, dah, s)
+			if res.err != nil {
 				log.Debugw("error fetching share", "root", dah.String(), "row", s.Row, "col", s.Col)
 			}
 			// we don't really care about Share bodies at this point
 			// it also means we now saved the Share in local storage
-			select {
-			case errs <- err:
-			case <-ctx.Done():
-			}
+			results <- res
 		}(s)
 	}
 
+	result := &share.SamplingResult{Samples: make([]share.SampleResult, 0, len(samples))}
 	for range samples {
-		var err error
+		var res sampleResult
 		select {
-		case err = <-errs:
+		case res = <-results:
 		case <-ctx.Done():
-			err = ctx.Err()
+			return result, availabilityError(dah, ctx.Err())
 		}
 
-		if err != nil {
-			if !errors.Is(err, context.Canceled) {
-				log.Errorw("availability validation failed", "root", dah.String(), "err", err.Error())
-			}
-			if ipldFormat.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded) {
-				return share.ErrNotAvailable
-			}
+		result.Samples = append(result.Samples, res.SampleResult)
+		if res.err != nil {
+			return result, availabilityError(dah, res.err)
+		}
+	}
+
+	return result, nil
+}
 
-			return err
+// sampleBatch requests all the samples at once from the share.SampleGetter. In the adaptive mode,
+// the batch is given `params.SampleTimeout`, as a single sample is. The outcome of every sample is
+// reported from the shares returned, while the failed batch reports no samples. As the samples are
+// retrieved together, each of them is reported with the latency of the whole batch. Unlike the
+// other sampling routines, the retrieval error is returned as is.
+func (la *ShareAvailability) sampleBatch(
+	ctx context.Context,
+	sg share.SampleGetter,
+	dah *share.Root,
+	samples []Sample,
+) (*share.SamplingResult, error) {
+	if la.params.MaxExtraSamples > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, la.params.SampleTimeout)
+		defer cancel()
+	}
+
+	coords := make([]share.Coordinate, len(samples))
+	for i, s := range samples {
+		coords[i] = share.Coordinate{Row: s.Row, Col: s.Col}
+	}
+
+	log.Debugw("fetching samples", "root", dah.String(), "amount", len(coords))
+	ctx, src := getters.WithSource(ctx)
+	start := time.Now()
+	shares, err := sg.GetSamples(ctx, dah, coords)
+	if err == nil && len(shares) != len(coords) {
+		err = fmt.Errorf("expected %d samples, got %d", len(coords), len(shares))
+	}
+	if err != nil {
+		return &share.SamplingResult{}, err
+	}
+
+	latency := time.Since(start)
+	result := &share.SamplingResult{Samples: make([]share.SampleResult, len(samples))}
+	for i, s := range samples {
+		var sampleErr error
+		if len(shares[i]) == 0 {
+			sampleErr = errMissingSample
+			err = sampleErr
 		}
+		res := newSampleResult(s, start, src, sampleErr)
+		res.Latency = latency
+		result.Samples[i] = res.SampleResult
+	}
+	return result, err
+}
+
+// sampleAdaptive requests the samples one by one, giving each of them `params.SampleTimeout`.
+// Samples that time out are replaced with new ones, until `params.MaxExtraSamples` replacements
+// are taken.
+func (la *ShareAvailability) sampleAdaptive(
+	ctx context.Context,
+	dah *share.Root,
+	samples []Sample,
+) (*share.SamplingResult, error) {
+	sampler := newSquareSampler(len(dah.RowsRoots), len(samples))
+	for _, s := range samples {
+		sampler.smpls[s] = struct{}{}
 	}
 
-	return nil
+	// buffered, so that no fetching routine blocks after an early return
+	results := make(chan sampleResult, len(samples)+int(la.params.MaxExtraSamples))
+	fetch := func(s Sample) {
+		go func() {
+			ctx, cancel := context.WithTimeout(ctx, la.params.SampleTimeout)
+			defer cancel()
+			results <- la.fetchSample(ctx, dah, s)
+		}()
+	}
+	for _, s := range samples {
+		fetch(s)
+	}
+
+	var extra uint
+	result := &share.SamplingResult{Samples: make([]share.SampleResult, 0, len(samples))}
+	for pending := len(samples); pending > 0; pending-- {
+		var res sampleResult
+		select {
+		case res = <-results:
+		case <-ctx.Done():
+			return result, availabilityError(dah, ctx.Err())
+		}
+
+		result.Samples = append(result.Samples, res.SampleResult)
+		if res.err == nil {
+			continue
+		}
+		// only the timeouts of a single sample are tolerated
+		if !errors.Is(res.err, context.DeadlineExceeded) || ctx.Err() != nil ||
+			extra >= la.params.MaxExtraSamples {
+			return result, availabilityError(dah, res.err)
+		}
+
+		s, ok := sampler.addSample()
+		if !ok {
+			return result, availabilityError(dah, res.err)
+		}
+		log.Debugw("sample timed out, taking an extra one",
+			"root", dah.String(), "row", res.Coordinate.Row, "col", res.Coordinate.Col)
+		extra++
+		pending++
+		fetch(s)
+	}
+	return result, nil
+}
+
+// sampleResult is share.SampleResult along with the original retrieval error.
+type sampleResult struct {
+	share.SampleResult
+	err error
+}
+
+// fetchSample retrieves the share at the given Sample and reports the outcome.
+func (la *ShareAvailability) fetchSample(ctx context.Context, dah *share.Root, s Sample) sampleResult {
+	ctx, src := getters.WithSource(ctx)
+	start := time.Now()
+	_, err := la.getter.GetShare(ctx, dah, s.Row, s.Col)
+	return newSampleResult(s, start, src, err)
+}
+
+func newSampleResult(s Sample, start time.Time, src *getters.Source, err error) sampleResult {
+	res := sampleResult{
+		SampleResult: share.SampleResult{
+			Coordinate: share.Coordinate{Row: s.Row, Col: s.Col},
+			Success:    err == nil,
+			Latency:    time.Since(start),
+			Source:     src.String(),
+		},
+		err: err,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// availabilityError converts the error of share retrieval into the result of availability
+// validation.
+func availabilityError(dah *share.Root, err error) error {
+	if !errors.Is(err, context.Canceled) {
+		log.Errorw("availability validation failed", "root", dah.String(), "err", err.Error())
+	}
+	if ipldFormat.IsNotFound(err) {
+		return share.ErrNotAvailable
+	}
+	// keep the timeout distinguishable from the data confirmed to be missing
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", share.ErrNotAvailable, err)
+	}
+
+	return err
+}
+
+// SampleCount returns the amount of Shares sampled over the given Root.
+func (la *ShareAvailability) SampleCount(dah *share.Root) int {
+	width := len(dah.RowsRoots)
+	amount := la.params.sampleAmount(width)
+	// mirrors the limit applied by SampleSquare
+	if amount > width*width {
+		return width
+	}
+	return amount
 }
 
 // ProbabilityOfAvailability calculates the probability that the
 // data square is available based on the amount of samples collected
-// (params.SampleAmount).
+// (params.SampleAmount). If the confidence target is set, it is returned instead,
+// as the amount of samples is derived from it.
 //
 // Formula: 1 - (0.75 ** amount of samples)
 func (la *ShareAvailability) ProbabilityOfAvailability(context.Context) float64 {
+	if la.params.Confidence > 0 {
+		return la.params.Confidence
+	}
 	return 1 - math.Pow(0.75, float64(la.params.SampleAmount))
 }
@@ -7,9 +7,13 @@ import (
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	mrand "math/rand"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,6 +26,7 @@ import (
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
 	availability_test "github.com/celestiaorg/celestia-node/share/availability/test"
+	"github.com/celestiaorg/celestia-node/share/getters"
 )
 
 func TestSharesAvailable(t *testing.T) {
@@ -34,6 +39,161 @@ func TestSharesAvailable(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSharesAvailable_SampleGetter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, dah := GetterWithRandSquare(t, 16)
+	// CascadeGetter implements share.SampleGetter, so all the samples are requested at once
+	avail := TestAvailability(getters.NewCascadeGetter([]share.Getter{getter}))
+	err := avail.SharesAvailable(ctx, dah)
+	assert.NoError(t, err)
+
+	empty := header.EmptyDAH()
+	err = avail.SharesAvailable(ctx, &empty)
+	assert.Error(t, err)
+}
+
+func TestSharesAvailableWithResult_SampleGetter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, dah := GetterWithRandSquare(t, 16)
+	batched := &batchGetter{stallingGetter: &stallingGetter{Getter: getter}}
+	avail := TestAvailability(batched)
+	result, err := avail.SharesAvailableWithResult(ctx, dah)
+	require.NoError(t, err)
+	require.Len(t, result.Samples, int(DefaultSampleAmount))
+	for _, res := range result.Samples {
+		assert.True(t, res.Success)
+		assert.Equal(t, result.Samples[0].Latency, res.Latency)
+	}
+
+	// once the batch fails, the samples are requested one by one to attribute the failure
+	failing := &failingGetter{Getter: getter}
+	batched = &batchGetter{stallingGetter: &stallingGetter{Getter: failing}}
+	avail = TestAvailability(batched)
+	result, err = avail.SharesAvailableWithResult(ctx, dah)
+	require.ErrorIs(t, err, errFailingShare)
+	require.Len(t, result.Failed(), 1)
+	assert.Equal(t, failing.failed, result.Failed()[0].Coordinate)
+	assert.Equal(t, errFailingShare.Error(), result.Failed()[0].Error)
+}
+
+var errFailingShare = errors.New("failing share")
+
+// failingGetter keeps failing to get the share at the coordinate it is first requested for.
+type failingGetter struct {
+	share.Getter
+	once   sync.Once
+	failed share.Coordinate
+}
+
+func (g *failingGetter) GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error) {
+	g.once.Do(func() {
+		g.failed = share.Coordinate{Row: row, Col: col}
+	})
+	if g.failed == (share.Coordinate{Row: row, Col: col}) {
+		return nil, errFailingShare
+	}
+	return g.Getter.GetShare(ctx, dah, row, col)
+}
+
+func TestSharesAvailable_Adaptive(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, dah := GetterWithRandSquare(t, 16)
+	stalling := &stallingGetter{Getter: getter, stalls: 2}
+	avail := NewShareAvailability(stalling, WithAdaptiveSampling(2, 100*time.Millisecond))
+	err := avail.SharesAvailable(ctx, dah)
+	require.NoError(t, err)
+	assert.EqualValues(t, DefaultSampleAmount+2, stalling.calls.Load())
+
+	// not enough extra samples to tolerate the timeouts
+	stalling = &stallingGetter{Getter: getter, stalls: 3}
+	avail = NewShareAvailability(stalling, WithAdaptiveSampling(2, 100*time.Millisecond))
+	err = avail.SharesAvailable(ctx, dah)
+	require.ErrorIs(t, err, share.ErrNotAvailable)
+}
+
+// stallingGetter stalls the first `stalls` GetShare requests until their context is done.
+type stallingGetter struct {
+	share.Getter
+	stalls int32
+	calls  atomic.Int32
+}
+
+func (g *stallingGetter) GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error) {
+	if g.calls.Add(1) <= g.stalls {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return g.Getter.GetShare(ctx, dah, row, col)
+}
+
+func TestSharesAvailable_AdaptiveBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, dah := GetterWithRandSquare(t, 16)
+	// the samples are requested at once, while the batch does not time out
+	batched := &batchGetter{stallingGetter: &stallingGetter{Getter: getter}}
+	avail := NewShareAvailability(batched, WithAdaptiveSampling(2, 100*time.Millisecond))
+	err := avail.SharesAvailable(ctx, dah)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, batched.batches.Load())
+	assert.Zero(t, batched.calls.Load())
+
+	// once the batch times out, the samples are requested one by one and replaced on timeouts
+	batched = &batchGetter{stallingGetter: &stallingGetter{Getter: getter, stalls: 2}, stalls: 1}
+	avail = NewShareAvailability(batched, WithAdaptiveSampling(2, 100*time.Millisecond))
+	err = avail.SharesAvailable(ctx, dah)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, batched.batches.Load())
+	assert.EqualValues(t, DefaultSampleAmount+2, batched.calls.Load())
+}
+
+// batchGetter implements share.SampleGetter over the stallingGetter, stalling the first `stalls`
+// batches until their context is done.
+type batchGetter struct {
+	*stallingGetter
+	stalls  int32
+	batches atomic.Int32
+}
+
+func (g *batchGetter) GetSamples(
+	ctx context.Context,
+	dah *share.Root,
+	coords []share.Coordinate,
+) ([]share.Share, error) {
+	if g.batches.Add(1) <= g.stalls {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	shares := make([]share.Share, len(coords))
+	for i, coord := range coords {
+		sh, err := g.Getter.GetShare(ctx, dah, coord.Row, coord.Col)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = sh
+	}
+	return shares, nil
+}
+
+func TestSharesAvailable_Confidence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getter, dah := GetterWithRandSquare(t, 16)
+	avail := NewShareAvailability(getter, WithConfidence(0.9999))
+	err := avail.SharesAvailable(ctx, dah)
+	require.NoError(t, err)
+	assert.Equal(t, 0.9999, avail.ProbabilityOfAvailability(ctx))
+	assert.Equal(t, samplesForConfidence(len(dah.RowsRoots), 0.9999), avail.SampleCount(dah))
+}
+
 func TestSharesAvailableFailed(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -2,18 +2,33 @@ package light
 
 import (
 	"fmt"
+	"time"
 )
 
 // SampleAmount specifies the minimum required amount of samples a light node must perform
 // before declaring that a block is available
 var (
 	DefaultSampleAmount uint = 16
+	// DefaultSampleTimeout is the time given to a single sample in the adaptive mode before it is
+	// replaced with another one.
+	DefaultSampleTimeout = 10 * time.Second
 )
 
 // Parameters is the set of Parameters that must be configured for the light
 // availability implementation
 type Parameters struct {
 	SampleAmount uint // The minimum required amount of samples to perform
+	// Confidence is the target probability of detecting withheld data in a square. If set, the
+	// amount of samples is derived per square from its width and SampleAmount is ignored.
+	Confidence float64
+	// MaxExtraSamples enables the adaptive mode if set. In the adaptive mode, a sample that
+	// times out is replaced with a new one instead of failing the square, until MaxExtraSamples
+	// of replacements are taken. Getters able to retrieve samples in batches are still asked for
+	// all the samples at once first, and the samples are only requested one by one if the batch
+	// times out.
+	MaxExtraSamples uint
+	// SampleTimeout is the time given to a single sample, or a batch of them, in the adaptive mode.
+	SampleTimeout time.Duration
 }
 
 // Option is a function that configures light availability Parameters
@@ -23,7 +38,8 @@ type Option func(*Parameters)
 // for the light availability implementation
 func DefaultParameters() Parameters {
 	return Parameters{
-		SampleAmount: DefaultSampleAmount,
+		SampleAmount:  DefaultSampleAmount,
+		SampleTimeout: DefaultSampleTimeout,
 	}
 }
 
@@ -38,6 +54,24 @@ func (p *Parameters) Validate() error {
 		)
 	}
 
+	if p.Confidence < 0 || p.Confidence >= 1 {
+		return fmt.Errorf(
+			"light availability: invalid option: value %s was %s, where it should be %s",
+			"Confidence",
+			fmt.Sprintf("%v", p.Confidence), // current value
+			"within [0, 1)",                 // what the value should be
+		)
+	}
+
+	if p.MaxExtraSamples > 0 && p.SampleTimeout <= 0 {
+		return fmt.Errorf(
+			"light availability: invalid option: value %s was %s, where it should be %s",
+			"SampleTimeout",
+			"<= 0",                     // current value
+			"> 0 in the adaptive mode", // what the value should be
+		)
+	}
+
 	return nil
 }
 
@@ -48,3 +82,50 @@ func WithSampleAmount(sampleAmount uint) Option {
 		p.SampleAmount = sampleAmount
 	}
 }
+
+// WithConfidence is a functional option that the Availability interface
+// implementers use to set the Confidence configuration param
+func WithConfidence(confidence float64) Option {
+	return func(p *Parameters) {
+		p.Confidence = confidence
+	}
+}
+
+// WithAdaptiveSampling is a functional option that the Availability interface
+// implementers use to enable the adaptive mode by setting the MaxExtraSamples and SampleTimeout
+// configuration params
+func WithAdaptiveSampling(maxExtraSamples uint, sampleTimeout time.Duration) Option {
+	return func(p *Parameters) {
+		p.MaxExtraSamples = maxExtraSamples
+		p.SampleTimeout = sampleTimeout
+	}
+}
+
+// sampleAmount returns the amount of samples to perform over a square of the given width.
+func (p *Parameters) sampleAmount(width int) int {
+	if p.Confidence == 0 {
+		return int(p.SampleAmount)
+	}
+	return samplesForConfidence(width, p.Confidence)
+}
+
+// samplesForConfidence returns the minimal amount of unique samples over a square of the given
+// width, such that the withholding of the smallest unrecoverable portion of the square is detected
+// with at least the given confidence.
+func samplesForConfidence(width int, confidence float64) int {
+	// the square is unrecoverable once (k+1)^2 shares are withheld, where k is the original width
+	total, withheld := width*width, (width/2+1)*(width/2+1)
+	// probability for all the samples taken to miss the withheld shares
+	miss := 1.0
+	for taken := 0; taken < total; taken++ {
+		available := total - withheld - taken
+		if available < 0 {
+			available = 0
+		}
+		miss *= float64(available) / float64(total-taken)
+		if 1-miss >= confidence {
+			return taken + 1
+		}
+	}
+	return total
+}
@@ -58,6 +58,28 @@ func (ss *squareSampler) generateSample(num int) error {
 	return nil
 }
 
+// addSample randomly picks a point that was not picked yet. It returns false if the whole square
+// is already picked.
+func (ss *squareSampler) addSample() (Sample, bool) {
+	if len(ss.smpls) >= ss.squareWidth*ss.squareWidth {
+		return Sample{}, false
+	}
+
+	for {
+		s := Sample{
+			Row: randInt(ss.squareWidth),
+			Col: randInt(ss.squareWidth),
+		}
+
+		if _, ok := ss.smpls[s]; ok {
+			continue
+		}
+
+		ss.smpls[s] = struct{}{}
+		return s, true
+	}
+}
+
 func (ss *squareSampler) samples() []Sample {
 	samples := make([]Sample, 0, len(ss.smpls))
 	for s := range ss.smpls {
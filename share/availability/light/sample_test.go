@@ -1,6 +1,7 @@
 package light
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,3 +35,19 @@ func TestSampleSquare(t *testing.T) {
 		}
 	}
 }
+
+func TestSamplesForConfidence(t *testing.T) {
+	// sampling without replacement needs at most as many samples as with replacement does
+	fixed := 1 - math.Pow(0.75, float64(DefaultSampleAmount))
+	assert.LessOrEqual(t, samplesForConfidence(256, fixed), int(DefaultSampleAmount))
+
+	for _, width := range []int{2, 4, 16, 128} {
+		prev := 0
+		for _, confidence := range []float64{0.9, 0.99, 0.9999, 0.999999} {
+			amount := samplesForConfidence(width, confidence)
+			assert.GreaterOrEqual(t, amount, prev)
+			assert.LessOrEqual(t, amount, width*width)
+			prev = amount
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package share
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// AxisShares represents a continuous set of shares of a single EDS row or column with the nmt
+// proof of their inclusion into the axis root.
+type AxisShares struct {
+	Shares []Share
+	Proof  *nmt.Proof
+}
+
+type jsonAxisShares struct {
+	Shares []Share    `json:"shares"`
+	Proof  *jsonProof `json:"proof"`
+}
+
+type jsonProof struct {
+	Start                   int      `json:"start"`
+	End                     int      `json:"end"`
+	Nodes                   [][]byte `json:"nodes"`
+	IsMaxNamespaceIDIgnored bool     `json:"is_max_namespace_id_ignored"`
+}
+
+// MarshalJSON encodes the AxisShares, exposing the otherwise unexported fields of the nmt.Proof.
+func (as AxisShares) MarshalJSON() ([]byte, error) {
+	out := jsonAxisShares{Shares: as.Shares}
+	if as.Proof != nil {
+		out.Proof = &jsonProof{
+			Start:                   as.Proof.Start(),
+			End:                     as.Proof.End(),
+			Nodes:                   as.Proof.Nodes(),
+			IsMaxNamespaceIDIgnored: as.Proof.IsMaxNamespaceIDIgnored(),
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the AxisShares encoded with MarshalJSON.
+func (as *AxisShares) UnmarshalJSON(data []byte) error {
+	var in jsonAxisShares
+	err := json.Unmarshal(data, &in)
+	if err != nil {
+		return err
+	}
+
+	as.Shares, as.Proof = in.Shares, nil
+	if in.Proof != nil {
+		proof := nmt.NewInclusionProof(in.Proof.Start, in.Proof.End, in.Proof.Nodes, in.Proof.IsMaxNamespaceIDIgnored)
+		as.Proof = &proof
+	}
+	return nil
+}
+
+// RangeShares represents the shares within a range of the original data square, split by the rows
+// they belong to. Every AxisShares is proven against the root of its row.
+type RangeShares []AxisShares
+
+// Flatten returns the concatenated slice of all RangeShares shares.
+func (rs RangeShares) Flatten() []Share {
+	shares := make([]Share, 0)
+	for _, row := range rs {
+		shares = append(shares, row.Shares...)
+	}
+	return shares
+}
+
+// AxisRoots returns the roots of the given axis of the Root.
+func AxisRoots(root *Root, axis rsmt2d.Axis) [][]byte {
+	if axis == rsmt2d.Col {
+		return root.ColumnRoots
+	}
+	return root.RowsRoots
+}
+
+// ValidateAxis checks that the axis index is within the EDS of the given Root.
+func ValidateAxis(root *Root, idx int, axis rsmt2d.Axis) error {
+	if axis != rsmt2d.Row && axis != rsmt2d.Col {
+		return fmt.Errorf("unknown axis: %d", axis)
+	}
+	if idx < 0 || idx >= len(AxisRoots(root, axis)) {
+		return fmt.Errorf("%s index %d is out of bounds [0, %d)", axis, idx, len(AxisRoots(root, axis)))
+	}
+	return nil
+}
+
+// ValidateRange checks that the range [start, end) of share indexes counted row-by-row is within
+// the original data square of the given Root.
+func ValidateRange(root *Root, start, end int) error {
+	odsWidth := len(root.RowsRoots) / 2
+	if start < 0 || end <= start || end > odsWidth*odsWidth {
+		return fmt.Errorf("invalid range [%d, %d) for the original data square of %d shares",
+			start, end, odsWidth*odsWidth)
+	}
+	return nil
+}
+
+// RangeRows splits the range [start, end) of the original data square share indexes counted
+// row-by-row into the rows it spans. For every row, the given function is called with the row
+// index and the bounds of the range within the row.
+func RangeRows(root *Root, start, end int, fn func(row, colStart, colEnd int)) {
+	odsWidth := len(root.RowsRoots) / 2
+	for row := start / odsWidth; row <= (end-1)/odsWidth; row++ {
+		colStart, colEnd := 0, odsWidth
+		if row == start/odsWidth {
+			colStart = start % odsWidth
+		}
+		if row == (end-1)/odsWidth {
+			colEnd = (end-1)%odsWidth + 1
+		}
+		fn(row, colStart, colEnd)
+	}
+}
+
+// ProveAxis builds the nmt over all the shares of the given axis and proves the inclusion of
+// the shares within [start, end) into the axis root. It errors if the shares do not match the
+// axis root of the given Root.
+func ProveAxis(root *Root, axis rsmt2d.Axis, idx int, shares []Share, start, end int) (*AxisShares, error) {
+	axisRoots := AxisRoots(root, axis)
+	if len(shares) != len(axisRoots) {
+		return nil, fmt.Errorf("expected %d shares in %s %d, got %d", len(axisRoots), axis, idx, len(shares))
+	}
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(len(shares)/2), uint(idx))
+	for _, sh := range shares {
+		tree.Push(sh)
+	}
+	if !bytes.Equal(tree.Root(), axisRoots[idx]) {
+		return nil, fmt.Errorf("shares of %s %d do not match its root", axis, idx)
+	}
+
+	proof, err := tree.Tree().ProveRange(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("proving shares of %s %d: %w", axis, idx, err)
+	}
+	return &AxisShares{
+		Shares: shares[start:end],
+		Proof:  &proof,
+	}, nil
+}
@@ -112,6 +112,23 @@ func (bc *blockstoreCache) unsafeAdd(
 	return newAccessor, nil
 }
 
+// Remove removes the blockstore for a given shard key from the cache, closing its accessor.
+func (bc *blockstoreCache) Remove(shardContainingCid shard.Key) {
+	lk := &bc.stripedLocks[shardKeyToStriped(shardContainingCid)]
+	lk.Lock()
+	defer lk.Unlock()
+
+	accessor, err := bc.unsafeGet(shardContainingCid)
+	if err != nil {
+		return
+	}
+	// the eviction callback is not called by lru.Cache.Remove, so the accessor is closed here
+	bc.cache.Remove(shardContainingCid)
+	if err := accessor.sa.Close(); err != nil {
+		log.Errorf("couldn't close accessor after cache removal: %s", err)
+	}
+}
+
 // shardKeyToStriped returns the index of the lock to use for a given shard key. We use the last
 // byte of the shard key as the pseudo-random index.
 func shardKeyToStriped(sk shard.Key) byte {
@@ -3,12 +3,14 @@ package eds
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/filecoin-project/dagstore/index"
 	"github.com/filecoin-project/dagstore/shard"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/namespace"
+	carindex "github.com/ipld/go-car/v2/index"
 	"github.com/multiformats/go-multihash"
 )
 
@@ -86,3 +88,63 @@ func (s *simpleInvertedIndex) GetShardsForMultihash(ctx context.Context, mh mult
 
 	return []shard.Key{shardKey}, nil
 }
+
+// RemoveMultihashesForShard removes the (multihash -> shard key) mappings pointing to the given
+// shard for all multihashes returned by the given MultihashIterator. Mappings of the multihashes
+// that point to other shards are kept.
+func (s *simpleInvertedIndex) RemoveMultihashesForShard(
+	ctx context.Context,
+	mhIter index.MultihashIterator,
+	sk shard.Key,
+) error {
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create ds batch: %w", err)
+	}
+
+	if err := mhIter.ForEach(func(mh multihash.Multihash) error {
+		key := ds.NewKey(string(mh))
+		sbz, err := s.ds.Get(ctx, key)
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lookup index for mh %s, err: %w", mh, err)
+		}
+
+		var shardKey shard.Key
+		if err := json.Unmarshal(sbz, &shardKey); err != nil {
+			return fmt.Errorf("failed to unmarshal shard key for mh=%s, err=%w", mh, err)
+		}
+		if shardKey != sk {
+			return nil
+		}
+
+		if err := batch.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete mh=%s, err=%w", mh, err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to remove index entry: %w", err)
+	}
+
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	if err := s.ds.Sync(ctx, ds.Key{}); err != nil {
+		return fmt.Errorf("failed to sync deletes: %w", err)
+	}
+	return nil
+}
+
+// mhIterator adapts the CAR index.IterableIndex to the iterator required by the inverted index.
+type mhIterator struct {
+	iterableIdx carindex.IterableIndex
+}
+
+func (it *mhIterator) ForEach(fn func(mh multihash.Multihash) error) error {
+	return it.iterableIdx.ForEach(func(mh multihash.Multihash, _ uint64) error {
+		return fn(mh)
+	})
+}
@@ -0,0 +1,74 @@
+package eds
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+)
+
+const failedKey = "failed"
+
+var (
+	meter = global.MeterProvider().Meter("eds_store")
+)
+
+type prunerMetrics struct {
+	prunedHeights syncint64.Counter
+	pruneTime     syncfloat64.Histogram // attributes: failed
+}
+
+// WithMetrics turns on metric collection in the Pruner.
+func (p *Pruner) WithMetrics() error {
+	prunedHeights, err := meter.SyncInt64().Counter("eds_pruner_pruned_heights_counter",
+		instrument.WithDescription("amount of heights whose EDSes have been pruned"))
+	if err != nil {
+		return err
+	}
+
+	pruneTime, err := meter.SyncFloat64().Histogram("eds_pruner_prune_time_hist",
+		instrument.WithDescription("duration of a single pruning run"))
+	if err != nil {
+		return err
+	}
+
+	lastPruned, err := meter.AsyncInt64().Gauge("eds_pruner_last_pruned_height",
+		instrument.WithDescription("last height whose EDS has been pruned"))
+	if err != nil {
+		return err
+	}
+
+	err = meter.RegisterCallback(
+		[]instrument.Asynchronous{
+			lastPruned,
+		},
+		func(ctx context.Context) {
+			lastPruned.Observe(ctx, int64(p.LastPruned()))
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.metrics = &prunerMetrics{
+		prunedHeights: prunedHeights,
+		pruneTime:     pruneTime,
+	}
+	return nil
+}
+
+func (m *prunerMetrics) observePrune(ctx context.Context, pruned uint64, dur time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	m.prunedHeights.Add(ctx, int64(pruned))
+	m.pruneTime.Record(ctx, dur.Seconds(), attribute.Bool(failedKey, err != nil))
+}
@@ -0,0 +1,241 @@
+package eds
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+var (
+	prunerPrefix     = datastore.NewKey("pruner")
+	lastPrunedKey    = datastore.NewKey("last_pruned")
+	defaultBatchSize = uint64(100)
+)
+
+// PrunerParameters is the set of parameters that configure the Pruner.
+type PrunerParameters struct {
+	// KeepHeights is the amount of the most recent heights whose EDSes are kept in the Store. Zero
+	// disables the limit.
+	KeepHeights uint64
+	// KeepDuration is the time for which the EDSes are kept in the Store, counting from the time of
+	// their block. Zero disables the limit.
+	//
+	// When both KeepHeights and KeepDuration are set, the EDS is kept as long as it is within either of
+	// them. When neither is set, pruning is disabled.
+	KeepDuration time.Duration
+	// PruneInterval is the interval at which the Pruner removes the EDSes that fell out of the
+	// retention window.
+	PruneInterval time.Duration
+}
+
+// DefaultPrunerParameters returns the default configuration values for the Pruner. Pruning is
+// disabled by default.
+func DefaultPrunerParameters() PrunerParameters {
+	return PrunerParameters{
+		PruneInterval: time.Minute * 5,
+	}
+}
+
+// Validate validates the values in PrunerParameters.
+func (p *PrunerParameters) Validate() error {
+	if p.Enabled() && p.PruneInterval <= 0 {
+		return fmt.Errorf("eds/pruner: prune interval must be positive")
+	}
+	return nil
+}
+
+// Enabled reports whether any retention window is set.
+func (p *PrunerParameters) Enabled() bool {
+	return p.KeepHeights > 0 || p.KeepDuration > 0
+}
+
+// Pruner periodically removes the EDSes of the blocks that fell out of the retention window from
+// the Store. Heights are mapped to the EDSes through the header store. The last pruned height is
+// persisted, so that pruning resumes where it stopped after restarts.
+type Pruner struct {
+	params PrunerParameters
+
+	store   *Store
+	getter  libhead.Getter[*header.ExtendedHeader]
+	ds      datastore.Datastore
+	metrics *prunerMetrics
+
+	lastPruned atomic.Uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPruner creates a new Pruner removing EDSes from the given Store.
+func NewPruner(
+	params PrunerParameters,
+	store *Store,
+	getter libhead.Getter[*header.ExtendedHeader],
+	ds datastore.Batching,
+) (*Pruner, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Pruner{
+		params: params,
+		store:  store,
+		getter: getter,
+		ds:     namespace.Wrap(ds, prunerPrefix),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Start loads the last pruned height and starts the pruning routine, if pruning is enabled.
+func (p *Pruner) Start(ctx context.Context) error {
+	if !p.params.Enabled() {
+		close(p.done)
+		return nil
+	}
+
+	bs, err := p.ds.Get(ctx, lastPrunedKey)
+	switch {
+	case err == nil:
+		p.lastPruned.Store(binary.BigEndian.Uint64(bs))
+	case !errors.Is(err, datastore.ErrNotFound):
+		return fmt.Errorf("eds/pruner: loading last pruned height: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	go p.run(ctx)
+	return nil
+}
+
+// Stop stops the pruning routine.
+func (p *Pruner) Stop(ctx context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// LastPruned returns the last height whose EDS has been pruned.
+func (p *Pruner) LastPruned() uint64 {
+	return p.lastPruned.Load()
+}
+
+func (p *Pruner) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.params.PruneInterval)
+	defer ticker.Stop()
+	for {
+		err := p.prune(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Errorw("pruning EDSes", "last_pruned", p.LastPruned(), "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prune removes the EDSes of all the heights that fell out of the retention window since the last
+// run.
+func (p *Pruner) prune(ctx context.Context) (err error) {
+	start, from := time.Now(), p.LastPruned()
+	defer func() {
+		p.metrics.observePrune(ctx, p.LastPruned()-from, time.Since(start), err)
+	}()
+
+	head, err := p.getter.Head(ctx)
+	if err != nil {
+		return fmt.Errorf("getting head: %w", err)
+	}
+
+	for height := from + 1; height <= uint64(head.Height()); height++ {
+		h, err := p.getter.GetByHeight(ctx, height)
+		switch {
+		case errors.Is(err, libhead.ErrNotFound):
+			// heights preceding the ones the header store was synced from have no EDS either
+		case err != nil:
+			return fmt.Errorf("getting header at height %d: %w", height, err)
+		case !p.outOfWindow(head, h):
+			return p.finishPrune(ctx, from)
+		default:
+			err = p.removeEDS(ctx, h)
+			if err != nil {
+				return err
+			}
+		}
+
+		p.lastPruned.Store(height)
+		if height%defaultBatchSize == 0 {
+			err = p.storeLastPruned(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.finishPrune(ctx, from)
+}
+
+// finishPrune persists the last pruned height if it advanced since the given one.
+func (p *Pruner) finishPrune(ctx context.Context, from uint64) error {
+	if p.LastPruned() == from {
+		return nil
+	}
+	log.Debugw("pruned EDSes", "from", from+1, "to", p.LastPruned())
+	return p.storeLastPruned(ctx)
+}
+
+// outOfWindow reports whether the given header fell out of the retention window.
+func (p *Pruner) outOfWindow(head, h *header.ExtendedHeader) bool {
+	if p.params.KeepHeights > 0 && uint64(head.Height()-h.Height()) < p.params.KeepHeights {
+		return false
+	}
+	if p.params.KeepDuration > 0 && time.Since(h.Time()) < p.params.KeepDuration {
+		return false
+	}
+	return true
+}
+
+func (p *Pruner) removeEDS(ctx context.Context, h *header.ExtendedHeader) error {
+	// the empty EDS is shared by all the empty blocks and must always be available
+	if share.DataHash(h.DAH.Hash()).IsEmptyRoot() {
+		return nil
+	}
+
+	err := p.store.Remove(ctx, h.DAH.Hash())
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("removing EDS at height %d: %w", h.Height(), err)
+	}
+	return nil
+}
+
+func (p *Pruner) storeLastPruned(ctx context.Context) error {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, p.LastPruned())
+	err := p.ds.Put(ctx, lastPrunedKey, bs)
+	if err != nil {
+		return fmt.Errorf("storing last pruned height: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package eds
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	carindex "github.com/ipld/go-car/v2/index"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+func TestPruner(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+	err = edsStore.Put(ctx, share.EmptyRoot().Hash(), share.EmptyExtendedDataSquare())
+	require.NoError(t, err)
+
+	// heights 1-10 are an hour apart, height 3 is an empty block and EDS of height 2 is not stored
+	getter := &testGetter{headers: make(map[uint64]*header.ExtendedHeader)}
+	now := time.Now()
+	for height := uint64(1); height <= 10; height++ {
+		eh := &header.ExtendedHeader{DAH: share.EmptyRoot()}
+		eh.RawHeader.Height = int64(height)
+		eh.RawHeader.Time = now.Add(-time.Hour * time.Duration(10-height))
+		getter.headers[height] = eh
+		if height == 3 {
+			continue
+		}
+
+		eds, dah := randomEDS(t)
+		eh.DAH = &dah
+		if height == 2 {
+			continue
+		}
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+	}
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	params := DefaultPrunerParameters()
+	params.KeepHeights = 6
+	params.KeepDuration = time.Hour*4 + time.Minute
+	pruner, err := NewPruner(params, edsStore, getter, ds)
+	require.NoError(t, err)
+
+	// heights 1-4 are out of both windows, while height 5 is still within the heights one
+	err = pruner.prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruner.LastPruned())
+	for height, eh := range getter.headers {
+		has, err := edsStore.Has(ctx, eh.DAH.Hash())
+		require.NoError(t, err)
+		require.Equal(t, height > 4 || height == 3, has, "height %d", height)
+	}
+
+	// the last pruned height is restored after restart
+	pruner, err = NewPruner(params, edsStore, getter, ds)
+	require.NoError(t, err)
+	err = pruner.Start(ctx)
+	require.NoError(t, err)
+	err = pruner.Stop(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, pruner.LastPruned())
+}
+
+func TestStore_RemoveCleansInvertedIndex(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	eds, dah := randomEDS(t)
+	err = edsStore.Put(ctx, dah.Hash(), eds)
+	require.NoError(t, err)
+
+	// cache the accessor, so that Remove has to release it first
+	_, err = edsStore.CARBlockstore(ctx, dah.Hash())
+	require.NoError(t, err)
+
+	idx, err := edsStore.carIdx.GetFullIndex(shard.KeyFromString(dah.String()))
+	require.NoError(t, err)
+	var mhs []multihash.Multihash
+	err = idx.(carindex.IterableIndex).ForEach(func(mh multihash.Multihash, _ uint64) error {
+		mhs = append(mhs, mh)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, mhs)
+
+	err = edsStore.Remove(ctx, dah.Hash())
+	require.NoError(t, err)
+	for _, mh := range mhs {
+		_, err = edsStore.topIdx.GetShardsForMultihash(ctx, mh)
+		require.ErrorIs(t, err, datastore.ErrNotFound)
+	}
+
+	err = edsStore.Remove(ctx, dah.Hash())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+type testGetter struct {
+	libhead.Getter[*header.ExtendedHeader]
+
+	headers map[uint64]*header.ExtendedHeader
+}
+
+func (g *testGetter) Head(context.Context) (*header.ExtendedHeader, error) {
+	return g.headers[uint64(len(g.headers))], nil
+}
+
+func (g *testGetter) GetByHeight(_ context.Context, height uint64) (*header.ExtendedHeader, error) {
+	eh, ok := g.headers[height]
+	if !ok {
+		return nil, libhead.ErrNotFound
+	}
+	return eh, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ipfs/go-datastore"
 	bstore "github.com/ipfs/go-ipfs-blockstore"
 	carv1 "github.com/ipld/go-car"
+	carindex "github.com/ipld/go-car/v2/index"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -52,7 +53,7 @@ type Store struct {
 	cache *blockstoreCache
 	bs    bstore.Blockstore
 
-	topIdx index.Inverted
+	topIdx *simpleInvertedIndex
 	carIdx index.FullIndexRepo
 
 	basepath   string
@@ -328,7 +329,7 @@ func (s *Store) getCachedAccessor(ctx context.Context, key shard.Key) (*accessor
 }
 
 // Remove removes EDS from Store by the given share.Root hash and cleans up all
-// the indexing.
+// the indexing. ErrNotFound is returned if the EDS is not in the Store.
 func (s *Store) Remove(ctx context.Context, root share.DataHash) (err error) {
 	ctx, span := tracer.Start(ctx, "store/remove", trace.WithAttributes(attribute.String("root", root.String())))
 	defer func() {
@@ -336,9 +337,22 @@ func (s *Store) Remove(ctx context.Context, root share.DataHash) (err error) {
 	}()
 
 	key := root.String()
+	// cached accessors hold a reference to the shard, which prevents it from being destroyed
+	s.cache.Remove(shard.KeyFromString(key))
+
+	// the full index is read before the shard is destroyed, as it is the only source of the
+	// multihashes to be cleaned up from the inverted index
+	idx, err := s.carIdx.GetFullIndex(shard.KeyFromString(key))
+	if err != nil {
+		log.Warnf("failed to get index for %s: %s", key, err)
+	}
+
 	ch := make(chan dagstore.ShardResult, 1)
 	err = s.dgstr.DestroyShard(ctx, shard.KeyFromString(key), ch, dagstore.DestroyOpts{})
 	if err != nil {
+		if errors.Is(err, dagstore.ErrShardUnknown) {
+			return ErrNotFound
+		}
 		return fmt.Errorf("failed to initiate shard destruction: %w", err)
 	}
 
@@ -351,6 +365,13 @@ func (s *Store) Remove(ctx context.Context, root share.DataHash) (err error) {
 		return ctx.Err()
 	}
 
+	if iterableIdx, ok := idx.(carindex.IterableIndex); ok {
+		err = s.topIdx.RemoveMultihashesForShard(ctx, &mhIterator{iterableIdx}, shard.KeyFromString(key))
+		if err != nil {
+			return fmt.Errorf("failed to remove multihashes from inverted index for %s: %w", key, err)
+		}
+	}
+
 	dropped, err := s.carIdx.DropFullIndex(shard.KeyFromString(key))
 	if !dropped {
 		log.Warnf("failed to drop index for %s", key)
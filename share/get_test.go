@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
 	"github.com/celestiaorg/nmt/namespace"
 	"github.com/celestiaorg/rsmt2d"
 
@@ -181,6 +182,49 @@ func TestGetSharesByNamespace(t *testing.T) {
 	}
 }
 
+func TestGetSharesByNamespace_AbsenceProof(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bServ := mdutils.Bserv()
+
+	// namespaces of the shares are even, so that the odd ones in between are absent
+	shares := RandShares(t, 16)
+	for i, sh := range shares {
+		copy(sh[:NamespaceSize], namespace.ID{1, 0, 0, 0, 0, 0, 0, byte(2*i + 2)})
+	}
+	eds, err := AddShares(ctx, shares, bServ)
+	require.NoError(t, err)
+
+	var absenceProofs int
+	for i := 0; i <= len(shares); i++ {
+		nID := namespace.ID{1, 0, 0, 0, 0, 0, 0, byte(2*i + 1)}
+		for _, rowRoot := range eds.RowRoots() {
+			rcid := ipld.MustCidFromNamespacedSha256(rowRoot)
+			rowShares, proof, err := GetSharesByNamespace(ctx, bServ, rcid, nID, len(eds.RowRoots()))
+			require.NoError(t, err)
+			require.Empty(t, rowShares)
+
+			row := NamespacedRow{Shares: rowShares, Proof: proof}
+			require.True(t, row.Verify(rowRoot, nID))
+
+			// only the rows whose namespace range brackets the namespace need the proof of absence
+			inRange := !nID.Less(nmt.MinNamespace(rowRoot, nID.Size())) &&
+				nID.Less(nmt.MaxNamespace(rowRoot, nID.Size()))
+			require.Equal(t, inRange, proof.IsOfAbsence())
+			if !inRange {
+				continue
+			}
+			absenceProofs++
+
+			// shares must not be accepted along with the proof of absence
+			row.Shares = shares[:1]
+			require.False(t, row.Verify(rowRoot, nID))
+		}
+	}
+	// every odd namespace but the ones outside the data and between the rows is absent within a row
+	require.Equal(t, len(shares)-4, absenceProofs)
+}
+
 func TestCollectLeavesByNamespace_IncompleteData(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/minio/sha256-simd"
 
@@ -27,13 +28,81 @@ type Getter interface {
 	GetEDS(context.Context, *Root) (*rsmt2d.ExtendedDataSquare, error)
 
 	// GetSharesByNamespace gets all shares from an EDS within the given namespace.
-	// Shares are returned in a row-by-row order if the namespace spans multiple rows.
+	// Shares are returned in a row-by-row order if the namespace spans multiple rows. The rows
+	// that may contain the namespace according to the Root, but do not, are returned with no shares
+	// and the proof of the namespace absence.
 	GetSharesByNamespace(context.Context, *Root, namespace.ID) (NamespacedShares, error)
+
+	// GetSharesByNamespaceStream gets the same shares as GetSharesByNamespace, but returns them
+	// row-by-row as they are retrieved instead of collecting the whole namespace in memory first.
+	GetSharesByNamespaceStream(context.Context, *Root, namespace.ID) (NamespacedRowIterator, error)
+
+	// GetSharesByNamespaces gets all shares from an EDS within any of the given namespaces at once.
+	// Namespaces that no row may contain according to the Root are omitted from the result.
+	// ErrNotFound is returned if none of them may be contained.
+	GetSharesByNamespaces(context.Context, *Root, []namespace.ID) (NamespacedSharesMap, error)
+
+	// GetRow gets all the shares of the row or column with the given index from an EDS along with
+	// the proof of their inclusion into the axis root.
+	GetRow(ctx context.Context, root *Root, idx int, axis rsmt2d.Axis) (*AxisShares, error)
+
+	// GetRange gets the shares of the original data square within the range of share indexes
+	// [start, end) counted row-by-row. Shares are returned split by rows, each with the proof of
+	// their inclusion into the row root.
+	GetRange(ctx context.Context, root *Root, start, end int) (RangeShares, error)
+}
+
+// SampleGetter is implemented by the Getters able to retrieve shares at multiple coordinates of an
+// EDS at once.
+type SampleGetter interface {
+	// GetSamples gets the shares at the given coordinates of the EDS identified by the given root.
+	// Shares are returned in the order of the coordinates.
+	GetSamples(ctx context.Context, root *Root, coords []Coordinate) ([]Share, error)
+}
+
+// Coordinate is the position of a share in an EDS.
+type Coordinate struct {
+	Row, Col int
 }
 
 // NamespacedShares represents all shares with proofs within a specific namespace of an EDS.
 type NamespacedShares []NamespacedRow
 
+// NamespacedSharesMap holds NamespacedShares of multiple namespaces keyed by the hex encoded
+// namespace ID.
+type NamespacedSharesMap map[string]NamespacedShares
+
+// Get returns the NamespacedShares within the given namespace ID or nil if there are none.
+func (m NamespacedSharesMap) Get(nID namespace.ID) NamespacedShares {
+	return m[nID.String()]
+}
+
+// NamespacedRowIterator iterates over the rows of NamespacedShares in the row-by-row order.
+type NamespacedRowIterator interface {
+	// Next returns the next NamespacedRow. It returns io.EOF once all the rows have been returned.
+	Next(context.Context) (NamespacedRow, error)
+	// Close releases the resources held by the iterator. It must be called even if not all the rows
+	// were read.
+	Close() error
+}
+
+// CollectNamespacedShares reads all the rows from the given NamespacedRowIterator and closes it.
+func CollectNamespacedShares(ctx context.Context, it NamespacedRowIterator) (NamespacedShares, error) {
+	defer it.Close()
+
+	var shares NamespacedShares
+	for {
+		row, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return shares, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, row)
+	}
+}
+
 // Flatten returns the concatenated slice of all NamespacedRow shares.
 func (ns NamespacedShares) Flatten() []Share {
 	shares := make([]Share, 0)
@@ -49,14 +118,11 @@ type NamespacedRow struct {
 	Proof  *nmt.Proof
 }
 
-// Verify validates NamespacedShares by checking every row with nmt inclusion proof.
+// Verify validates NamespacedShares by checking every row with nmt inclusion proof. The rows
+// that do not contain the namespace, while their namespace range brackets it, must come with the
+// nmt proof of the namespace absence.
 func (ns NamespacedShares) Verify(root *Root, nID namespace.ID) error {
-	originalRoots := make([][]byte, 0)
-	for _, row := range root.RowsRoots {
-		if !nID.Less(nmt.MinNamespace(row, nID.Size())) && nID.LessOrEqual(nmt.MaxNamespace(row, nID.Size())) {
-			originalRoots = append(originalRoots, row)
-		}
-	}
+	originalRoots := RowRootsByNamespace(root, nID)
 
 	if len(originalRoots) != len(ns) {
 		return fmt.Errorf("amount of rows differs between root and namespace shares: expected %d, got %d",
@@ -65,15 +131,36 @@ func (ns NamespacedShares) Verify(root *Root, nID namespace.ID) error {
 
 	for i, row := range ns {
 		// verify row data against row hash from original root
-		if !row.verify(originalRoots[i], nID) {
+		if !row.Verify(originalRoots[i], nID) {
 			return fmt.Errorf("row verification failed: row %d doesn't match original root: %s", i, root.Hash())
 		}
 	}
 	return nil
 }
 
-// verify validates the row using nmt inclusion proof.
-func (row *NamespacedRow) verify(rowRoot []byte, nID namespace.ID) bool {
+// RowRootsByNamespace returns the row roots of the given Root that may contain the given
+// namespace ID, i.e. the roots of the rows NamespacedShares consist of.
+func RowRootsByNamespace(root *Root, nID namespace.ID) [][]byte {
+	rowRoots := make([][]byte, 0)
+	for _, row := range root.RowsRoots {
+		if !nID.Less(nmt.MinNamespace(row, nID.Size())) && nID.LessOrEqual(nmt.MaxNamespace(row, nID.Size())) {
+			rowRoots = append(rowRoots, row)
+		}
+	}
+	return rowRoots
+}
+
+// Verify validates the row against the given row root using nmt inclusion proof or, if the row
+// has no shares, nmt absence proof.
+func (row *NamespacedRow) Verify(rowRoot []byte, nID namespace.ID) bool {
+	if row.Proof == nil {
+		return false
+	}
+	// absence proofs are verified regardless of the data, so no shares may come along with them
+	if row.Proof.IsOfAbsence() && len(row.Shares) != 0 {
+		return false
+	}
+
 	// construct nmt leaves from shares by prepending namespace
 	leaves := make([][]byte, 0, len(row.Shares))
 	for _, sh := range row.Shares {
@@ -14,7 +14,10 @@ import (
 	"github.com/celestiaorg/celestia-node/share"
 )
 
-var _ share.Getter = (*CascadeGetter)(nil)
+var (
+	_ share.Getter       = (*CascadeGetter)(nil)
+	_ share.SampleGetter = (*CascadeGetter)(nil)
+)
 
 // CascadeGetter implements custom share.Getter that composes multiple Getter implementations in
 // "cascading" order.
@@ -81,6 +84,111 @@ func (cg *CascadeGetter) GetSharesByNamespace(
 	return cascadeGetters(ctx, cg.getters, get)
 }
 
+// GetSharesByNamespaces gets NamespacedShares of multiple namespaces from any of registered
+// share.Getters in cascading order.
+func (cg *CascadeGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	ids []namespace.ID,
+) (share.NamespacedSharesMap, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("namespaces", len(ids)),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) (share.NamespacedSharesMap, error) {
+		return get.GetSharesByNamespaces(ctx, root, ids)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
+// GetSharesByNamespaceStream opens the stream of NamespacedShares rows from any of registered
+// share.Getters in cascading order. Once the stream is opened, the failures of reading it are not
+// cascaded.
+func (cg *CascadeGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	root *share.Root,
+	id namespace.ID,
+) (share.NamespacedRowIterator, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-shares-by-namespace-stream", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.String("nid", id.String()),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) (share.NamespacedRowIterator, error) {
+		return get.GetSharesByNamespaceStream(ctx, root, id)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
+// GetRow gets the shares of a row or column from any of registered share.Getters in cascading
+// order.
+func (cg *CascadeGetter) GetRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisShares, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-row", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("idx", idx),
+		attribute.Stringer("axis", axis),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) (*share.AxisShares, error) {
+		return get.GetRow(ctx, root, idx, axis)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
+// GetRange gets the shares within the range of the original data square from any of registered
+// share.Getters in cascading order.
+func (cg *CascadeGetter) GetRange(
+	ctx context.Context,
+	root *share.Root,
+	start, end int,
+) (share.RangeShares, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-range", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("start", start),
+		attribute.Int("end", end),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) (share.RangeShares, error) {
+		return get.GetRange(ctx, root, start, end)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
+// GetSamples gets the shares at the given coordinates from any of registered share.Getters in
+// cascading order. The getters not implementing share.SampleGetter are asked for every share
+// separately.
+func (cg *CascadeGetter) GetSamples(
+	ctx context.Context,
+	root *share.Root,
+	coords []share.Coordinate,
+) ([]share.Share, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-samples", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("samples", len(coords)),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) ([]share.Share, error) {
+		return getSamples(ctx, get, root, coords)
+	}
+
+	return cascadeGetters(ctx, cg.getters, get)
+}
+
 // cascade implements a cascading retry algorithm for getting a value from multiple sources.
 // Cascading implies trying the sources one-by-one in the given order with the
 // given interval until either:
@@ -42,6 +42,18 @@ func TestCascadeGetter(t *testing.T) {
 			assert.NotEmpty(t, sh)
 		}
 	})
+
+	t.Run("GetSamples", func(t *testing.T) {
+		coords := []share.Coordinate{{Row: 0, Col: 0}, {Row: 1, Col: 3}}
+		for _, r := range roots {
+			shs, err := getter.GetSamples(ctx, r, coords)
+			assert.NoError(t, err)
+			assert.Len(t, shs, len(coords))
+			for _, sh := range shs {
+				assert.NotEmpty(t, sh)
+			}
+		}
+	})
 }
 
 func TestCascade(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt/namespace"
 	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/share"
@@ -145,6 +146,112 @@ func TestStoreGetter(t *testing.T) {
 		_, err = sg.GetSharesByNamespace(ctx, &root, nID)
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
+
+	t.Run("GetSharesByNamespace_Absent", func(t *testing.T) {
+		eds, nID, dah := randomEDSWithAbsentNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// the first row brackets the namespace, so it comes with the proof of absence
+		shares, err := sg.GetSharesByNamespace(ctx, &dah, nID)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		assert.Empty(t, shares.Flatten())
+		assert.True(t, shares[0].Proof.IsOfAbsence())
+		require.NoError(t, shares.Verify(&dah, nID))
+	})
+
+	t.Run("GetSharesByNamespaces", func(t *testing.T) {
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// the first share's namespace, the doubled one, a duplicate and an absent one
+		firstNID := namespace.ID(eds.GetCell(0, 0)[:share.NamespaceSize])
+		absentNID := namespace.ID(make([]byte, share.NamespaceSize))
+		shares, err := sg.GetSharesByNamespaces(ctx, &dah, []namespace.ID{firstNID, nID, nID, absentNID})
+		require.NoError(t, err)
+		require.Len(t, shares, 2)
+		require.NoError(t, shares.Get(firstNID).Verify(&dah, firstNID))
+		require.NoError(t, shares.Get(nID).Verify(&dah, nID))
+		assert.Len(t, shares.Get(nID).Flatten(), 2)
+		assert.Nil(t, shares.Get(absentNID))
+
+		// none of the nids found
+		_, err = sg.GetSharesByNamespaces(ctx, &dah, []namespace.ID{absentNID})
+		require.ErrorIs(t, err, share.ErrNotFound)
+
+		// root not found
+		root := share.Root{}
+		_, err = sg.GetSharesByNamespaces(ctx, &root, []namespace.ID{nID})
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetSharesByNamespaceStream", func(t *testing.T) {
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		it, err := sg.GetSharesByNamespaceStream(ctx, &dah, nID)
+		require.NoError(t, err)
+		shares, err := share.CollectNamespacedShares(ctx, it)
+		require.NoError(t, err)
+		require.NoError(t, shares.Verify(&dah, nID))
+		assert.Len(t, shares.Flatten(), 2)
+
+		// nid not found
+		nID = make([]byte, 8)
+		_, err = sg.GetSharesByNamespaceStream(ctx, &dah, nID)
+		require.ErrorIs(t, err, share.ErrNotFound)
+
+		// root not found
+		root := share.Root{}
+		_, err = sg.GetSharesByNamespaceStream(ctx, &root, nID)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetRow", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			for i := 0; i < int(eds.Width()); i++ {
+				shares, err := sg.GetRow(ctx, &dah, i, axis)
+				require.NoError(t, err)
+				requireAxisShares(t, eds, shares, i, axis)
+			}
+		}
+
+		// index out of bounds
+		_, err = sg.GetRow(ctx, &dah, int(eds.Width()), rsmt2d.Row)
+		require.Error(t, err)
+
+		// root not found
+		_, dah = randomEDS(t)
+		_, err = sg.GetRow(ctx, &dah, 0, rsmt2d.Row)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetRange", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// the range spans three rows of the original data square
+		shares, err := sg.GetRange(ctx, &dah, 3, 9)
+		require.NoError(t, err)
+		requireRangeShares(t, eds, shares, 3, 9)
+
+		// range out of bounds
+		_, err = sg.GetRange(ctx, &dah, 0, 17)
+		require.Error(t, err)
+
+		// root not found
+		_, dah = randomEDS(t)
+		_, err = sg.GetRange(ctx, &dah, 0, 1)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
 }
 
 func TestIPLDGetter(t *testing.T) {
@@ -221,6 +328,112 @@ func TestIPLDGetter(t *testing.T) {
 		_, err = sg.GetSharesByNamespace(ctx, &root, nID)
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
+
+	t.Run("GetSharesByNamespace_Absent", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds, nID, dah := randomEDSWithAbsentNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// the first row brackets the namespace, so it comes with the proof of absence
+		shares, err := sg.GetSharesByNamespace(ctx, &dah, nID)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		assert.Empty(t, shares.Flatten())
+		assert.True(t, shares[0].Proof.IsOfAbsence())
+		require.NoError(t, shares.Verify(&dah, nID))
+	})
+
+	t.Run("GetSharesByNamespaces", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// the first share's namespace, the doubled one, a duplicate and an absent one
+		firstNID := namespace.ID(eds.GetCell(0, 0)[:share.NamespaceSize])
+		absentNID := namespace.ID(make([]byte, share.NamespaceSize))
+		shares, err := sg.GetSharesByNamespaces(ctx, &dah, []namespace.ID{firstNID, nID, nID, absentNID})
+		require.NoError(t, err)
+		require.Len(t, shares, 2)
+		require.NoError(t, shares.Get(firstNID).Verify(&dah, firstNID))
+		require.NoError(t, shares.Get(nID).Verify(&dah, nID))
+		assert.Len(t, shares.Get(nID).Flatten(), 2)
+		assert.Nil(t, shares.Get(absentNID))
+
+		// none of the nids found
+		_, err = sg.GetSharesByNamespaces(ctx, &dah, []namespace.ID{absentNID})
+		require.ErrorIs(t, err, share.ErrNotFound)
+
+		// root not found
+		root := share.Root{}
+		_, err = sg.GetSharesByNamespaces(ctx, &root, []namespace.ID{nID})
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetSharesByNamespaceStream", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		it, err := sg.GetSharesByNamespaceStream(ctx, &dah, nID)
+		require.NoError(t, err)
+		shares, err := share.CollectNamespacedShares(ctx, it)
+		require.NoError(t, err)
+		require.NoError(t, shares.Verify(&dah, nID))
+		assert.Len(t, shares.Flatten(), 2)
+
+		// nid not found
+		nID = make([]byte, 8)
+		_, err = sg.GetSharesByNamespaceStream(ctx, &dah, nID)
+		require.ErrorIs(t, err, share.ErrNotFound)
+
+		// root not found
+		root := share.Root{}
+		_, err = sg.GetSharesByNamespaceStream(ctx, &root, nID)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+
+	t.Run("GetRow", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+			for i := 0; i < int(eds.Width()); i++ {
+				shares, err := sg.GetRow(ctx, &dah, i, axis)
+				require.NoError(t, err)
+				requireAxisShares(t, eds, shares, i, axis)
+			}
+		}
+	})
+
+	t.Run("GetRange", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		shares, err := sg.GetRange(ctx, &dah, 5, 6)
+		require.NoError(t, err)
+		requireRangeShares(t, eds, shares, 5, 6)
+
+		shares, err = sg.GetRange(ctx, &dah, 0, 16)
+		require.NoError(t, err)
+		requireRangeShares(t, eds, shares, 0, 16)
+	})
 }
 
 func randomEDS(t *testing.T) (*rsmt2d.ExtendedDataSquare, share.Root) {
@@ -250,3 +463,60 @@ func randomEDSWithDoubledNamespace(t *testing.T, size int) (*rsmt2d.ExtendedData
 
 	return eds, randShares[idx1][:8], dah
 }
+
+// requireAxisShares checks the AxisShares hold all the shares of the given EDS axis.
+func requireAxisShares(
+	t *testing.T,
+	eds *rsmt2d.ExtendedDataSquare,
+	shares *share.AxisShares,
+	idx int,
+	axis rsmt2d.Axis,
+) {
+	expected := eds.Row(uint(idx))
+	if axis == rsmt2d.Col {
+		expected = eds.Col(uint(idx))
+	}
+	require.Equal(t, expected, shares.Shares)
+	require.Equal(t, 0, shares.Proof.Start())
+	require.Equal(t, len(expected), shares.Proof.End())
+}
+
+// requireRangeShares checks the RangeShares hold the shares of the given EDS original data square
+// within [start, end), with proofs of their positions in rows.
+func requireRangeShares(t *testing.T, eds *rsmt2d.ExtendedDataSquare, shares share.RangeShares, start, end int) {
+	odsWidth := int(eds.Width() / 2)
+	require.Len(t, shares, (end-1)/odsWidth-start/odsWidth+1)
+
+	var expected []share.Share
+	for i := start; i < end; i++ {
+		expected = append(expected, eds.GetCell(uint(i/odsWidth), uint(i%odsWidth)))
+	}
+	require.Equal(t, expected, shares.Flatten())
+
+	for i, row := range shares {
+		require.Equal(t, row.Proof.End()-row.Proof.Start(), len(row.Shares))
+		if i > 0 {
+			require.Equal(t, 0, row.Proof.Start())
+		}
+	}
+}
+
+// randomEDSWithAbsentNamespace generates a random EDS and a namespace ID, which is not present in it,
+// but is within the namespace range of its first row.
+func randomEDSWithAbsentNamespace(t *testing.T, size int) (*rsmt2d.ExtendedDataSquare, namespace.ID, share.Root) {
+	randShares := share.RandShares(t, size*size)
+	// namespaces of the shares are even, so that the odd ones in between are absent
+	for i, sh := range randShares {
+		copy(sh[:share.NamespaceSize], namespace.ID{1, 0, 0, 0, 0, 0, 0, byte(2*i + 2)})
+	}
+
+	eds, err := rsmt2d.ComputeExtendedDataSquare(
+		randShares,
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(uint64(size)),
+	)
+	require.NoError(t, err, "failure to recompute the extended data square")
+	dah := da.NewDataAvailabilityHeader(eds)
+
+	return eds, namespace.ID{1, 0, 0, 0, 0, 0, 0, 3}, dah
+}
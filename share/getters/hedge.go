@@ -0,0 +1,216 @@
+package getters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	"github.com/celestiaorg/celestia-node/share/p2p/peers"
+)
+
+// HedgeParameters configure the hedged mode of the ShrexGetter, in which shrex/eds and shrex/nd
+// requests are raced across multiple peers and the first verified response wins.
+type HedgeParameters struct {
+	// MaxParallel is the maximum amount of requests sent to different peers at the same time. Values
+	// below 2 disable the hedged mode, so that peers are requested one after another.
+	MaxParallel int
+	// Delay is the time after which a request is sent to another peer, if none of the in-flight
+	// requests has completed. Zero makes the getter fan out to MaxParallel peers at once.
+	Delay time.Duration
+}
+
+// DefaultHedgeParameters returns the default configuration values for the hedged mode, which is
+// disabled by default.
+func DefaultHedgeParameters() HedgeParameters {
+	return HedgeParameters{
+		MaxParallel: 1,
+		// Delay's default value is based on the time taken by healthy peers to serve shrex/nd requests
+		Delay: time.Second,
+	}
+}
+
+// Validate validates the values in HedgeParameters.
+func (p *HedgeParameters) Validate() error {
+	if p.MaxParallel < 0 {
+		return fmt.Errorf("getter/shrex: max parallel requests must not be negative")
+	}
+	if p.Delay < 0 {
+		return fmt.Errorf("getter/shrex: hedge delay must not be negative")
+	}
+	return nil
+}
+
+// Enabled reports whether requests are raced across multiple peers.
+func (p *HedgeParameters) Enabled() bool {
+	return p.MaxParallel > 1
+}
+
+// hedgedRequest is a request raced by the ShrexGetter across multiple peers.
+type hedgedRequest[T any] struct {
+	// name identifies the request in logs
+	name string
+	do   func(context.Context, peer.ID) (T, error)
+	// size returns the amount of bytes served in the response
+	size func(T) int
+	// synced reports whether the successful response completes syncing of the data hash
+	synced        bool
+	recordAttempt func(attempt int, success bool)
+}
+
+// hedgeResult is the outcome of a single request made in scope of a hedgedRequest.
+type hedgeResult[T any] struct {
+	val     T
+	err     error
+	peer    peer.ID
+	attempt int
+	start   time.Time
+	// noPeer is set if no peer could be found for the request
+	noPeer bool
+	// duplicate is set if the found peer is already requested, so the request was not sent
+	duplicate bool
+}
+
+// raceRequest sends the request to a peer and, if it does not complete within the hedge Delay,
+// to another one, until MaxParallel requests are in flight. Failed requests are replaced with new
+// ones right away. The first successful response is returned and the in-flight requests are
+// canceled.
+func raceRequest[T any](ctx context.Context, sg *ShrexGetter, root *share.Root, req hedgedRequest[T]) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// cancels the requests still in flight once the race is over
+	defer cancel()
+
+	var (
+		zero     T
+		attempt  int
+		inFlight int
+		err      error
+
+		// the results channel is buffered, so that the requests left after the race never block
+		results = make(chan hedgeResult[T], sg.hedge.MaxParallel)
+		lk      sync.Mutex
+		peerIDs = make(map[peer.ID]struct{})
+	)
+	launch := func() {
+		attempt++
+		inFlight++
+		go func(attempt int) {
+			res := hedgeResult[T]{attempt: attempt, start: time.Now()}
+			peerID, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+			if getErr != nil {
+				res.err, res.noPeer = getErr, true
+				results <- res
+				return
+			}
+			res.peer = peerID
+
+			lk.Lock()
+			_, res.duplicate = peerIDs[peerID]
+			peerIDs[peerID] = struct{}{}
+			lk.Unlock()
+			if res.duplicate {
+				setStatus(peers.ResultNoop)
+				results <- res
+				return
+			}
+
+			reqCtx, reqCancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
+			res.val, res.err = req.do(reqCtx, peerID)
+			reqCancel()
+			sg.observeRequest(ctx, peerID, res.start, req.size(res.val), res.err)
+			res.err = setHedgeStatus(setStatus, res.err, req.synced)
+
+			lk.Lock()
+			delete(peerIDs, peerID)
+			lk.Unlock()
+			results <- res
+		}(attempt)
+	}
+
+	var hedgeTimer <-chan time.Time
+	launch()
+	if sg.hedge.Delay == 0 {
+		for inFlight < sg.hedge.MaxParallel {
+			launch()
+		}
+	} else {
+		hedgeTimer = time.After(sg.hedge.Delay)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			req.recordAttempt(attempt, false)
+			return zero, ctx.Err()
+		case <-hedgeTimer:
+			if inFlight < sg.hedge.MaxParallel {
+				launch()
+			}
+			hedgeTimer = time.After(sg.hedge.Delay)
+		case res := <-results:
+			inFlight--
+			switch {
+			case res.err == nil && !res.duplicate:
+				req.recordAttempt(attempt, true)
+				return res.val, nil
+			case res.duplicate:
+				// the peer is requested already, the next hedge will try another one
+				if inFlight == 0 {
+					launch()
+				}
+				continue
+			case res.noPeer:
+				err = errors.Join(err, res.err)
+				log.Debugw(req.name+": couldn't find peer",
+					"hash", root.String(),
+					"err", res.err,
+					"finished (s)", time.Since(res.start))
+				if inFlight > 0 {
+					// the in-flight requests may still succeed
+					continue
+				}
+				req.recordAttempt(attempt, false)
+				return zero, fmt.Errorf("getter/shrex: %w", err)
+			}
+
+			if !ErrorContains(err, res.err) {
+				err = errors.Join(err, res.err)
+			}
+			log.Debugw(req.name+": request failed",
+				"hash", root.String(),
+				"peer", res.peer.String(),
+				"attempt", res.attempt,
+				"err", res.err,
+				"finished (s)", time.Since(res.start))
+			if ctx.Err() == nil {
+				launch()
+			}
+		}
+	}
+}
+
+// setHedgeStatus sets the result of the request to the peer according to the request error,
+// converting the error to satisfy the getter interface contract.
+func setHedgeStatus(setStatus peers.DoneFunc, err error, synced bool) error {
+	switch {
+	case err == nil && synced:
+		setStatus(peers.ResultSynced)
+	case err == nil:
+		setStatus(peers.ResultNoop)
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+	case errors.Is(err, p2p.ErrNotFound):
+		err = share.ErrNotFound
+		setStatus(peers.ResultCooldownPeer)
+	case errors.Is(err, p2p.ErrInvalidResponse):
+		setStatus(peers.ResultBlacklistPeer)
+	default:
+		setStatus(peers.ResultCooldownPeer)
+	}
+	return err
+}
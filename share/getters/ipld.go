@@ -63,6 +63,7 @@ func (ig *IPLDGetter) GetShare(ctx context.Context, dah *share.Root, row, col in
 		return nil, fmt.Errorf("getter/ipld: failed to retrieve share: %w", err)
 	}
 
+	setSource(ctx, sourceBitswap)
 	return s, nil
 }
 
@@ -117,6 +118,64 @@ func (ig *IPLDGetter) GetSharesByNamespace(
 	return shares, nil
 }
 
+func (ig *IPLDGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	nIDs []namespace.ID,
+) (shares share.NamespacedSharesMap, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("namespaces", len(nIDs)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = verifyNIDsSize(nIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: invalid namespace IDs: %w", err)
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	shares, err = collectSharesByNamespaces(ctx, blockGetter, root, nIDs)
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve shares by namespaces: %w", err)
+	}
+	return shares, nil
+}
+
+func (ig *IPLDGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	root *share.Root,
+	nID namespace.ID,
+) (it share.NamespacedRowIterator, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-shares-by-namespace-stream", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.String("nID", nID.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = verifyNIDSize(nID)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: invalid namespace ID: %w", err)
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	it, err = newNamespacedRowIterator(blockGetter, root, nID)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve shares by namespace: %w", err)
+	}
+	return it, nil
+}
+
 var sessionKey = &session{}
 
 // session is a struct that can optionally be passed by context to the share.Getter methods using
@@ -153,3 +212,63 @@ func getGetter(ctx context.Context, service blockservice.BlockService) blockserv
 	}
 	return val
 }
+
+// GetRow gets all the shares of the given row or column from the bitswap network.
+func (ig *IPLDGetter) GetRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (shares *share.AxisShares, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-row", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("idx", idx),
+		attribute.Stringer("axis", axis),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = share.ValidateAxis(root, idx, axis)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: %w", err)
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	shares, err = collectAxis(ctx, blockGetter, root, idx, axis, 0, len(root.RowsRoots))
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve %s: %w", axis, err)
+	}
+	return shares, nil
+}
+
+// GetRange gets the shares of the original data square within the given range from the bitswap
+// network.
+func (ig *IPLDGetter) GetRange(
+	ctx context.Context,
+	root *share.Root,
+	start, end int,
+) (shares share.RangeShares, err error) {
+	ctx, span := tracer.Start(ctx, "ipld/get-range", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("start", start),
+		attribute.Int("end", end),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = share.ValidateRange(root, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: %w", err)
+	}
+
+	// wrap the blockservice in a session if it has been signaled in the context.
+	blockGetter := getGetter(ctx, ig.bServ)
+	shares, err = collectRange(ctx, blockGetter, root, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("getter/ipld: failed to retrieve range: %w", err)
+	}
+	return shares, nil
+}
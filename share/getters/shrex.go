@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -20,9 +22,13 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 )
 
-var _ share.Getter = (*ShrexGetter)(nil)
+var (
+	_ share.Getter       = (*ShrexGetter)(nil)
+	_ share.SampleGetter = (*ShrexGetter)(nil)
+)
 
 const (
 	// defaultMinRequestTimeout value is set according to observed time taken by healthy peer to
@@ -35,8 +41,9 @@ const (
 var meter = global.MeterProvider().Meter("shrex/getter")
 
 type metrics struct {
-	edsAttempts syncint64.Histogram
-	ndAttempts  syncint64.Histogram
+	edsAttempts    syncint64.Histogram
+	ndAttempts     syncint64.Histogram
+	sampleAttempts syncint64.Histogram
 }
 
 func (m *metrics) recordEDSAttempt(attemptCount int, success bool) {
@@ -58,6 +65,16 @@ func (m *metrics) recordNDAttempt(attemptCount int, success bool) {
 	m.ndAttempts.Record(ctx, int64(attemptCount), attribute.Bool("success", success))
 }
 
+func (m *metrics) recordSampleAttempt(attemptCount int, success bool) {
+	if m == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricObservationTimeout)
+	defer cancel()
+	m.sampleAttempts.Record(ctx, int64(attemptCount), attribute.Bool("success", success))
+}
+
 func (sg *ShrexGetter) WithMetrics() error {
 	edsAttemptHistogram, err := meter.SyncInt64().Histogram(
 		"getters_shrex_eds_attempts_per_request",
@@ -77,17 +94,29 @@ func (sg *ShrexGetter) WithMetrics() error {
 		return err
 	}
 
+	sampleAttemptHistogram, err := meter.SyncInt64().Histogram(
+		"getters_shrex_sample_attempts_per_request",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of attempts per shrex/sample request"),
+	)
+	if err != nil {
+		return err
+	}
+
 	sg.metrics = &metrics{
-		edsAttempts: edsAttemptHistogram,
-		ndAttempts:  ndAttemptHistogram,
+		edsAttempts:    edsAttemptHistogram,
+		ndAttempts:     ndAttemptHistogram,
+		sampleAttempts: sampleAttemptHistogram,
 	}
 	return nil
 }
 
-// ShrexGetter is a share.Getter that uses the shrex/eds and shrex/nd protocol to retrieve shares.
+// ShrexGetter is a share.Getter that uses the shrex/eds, shrex/nd and shrex/sample protocols to
+// retrieve shares.
 type ShrexGetter struct {
-	edsClient *shrexeds.Client
-	ndClient  *shrexnd.Client
+	edsClient    *shrexeds.Client
+	ndClient     *shrexnd.Client
+	sampleClient *shrexsample.Client
 
 	peerManager *peers.Manager
 
@@ -96,17 +125,27 @@ type ShrexGetter struct {
 	// minAttemptsCount will be used to split request timeout into multiple attempts. It will allow to
 	// attempt multiple peers in scope of one request before context timeout is reached
 	minAttemptsCount int
+	// hedge configures racing of shrex/eds and shrex/nd requests across multiple peers
+	hedge HedgeParameters
 
 	metrics *metrics
 }
 
-func NewShrexGetter(edsClient *shrexeds.Client, ndClient *shrexnd.Client, peerManager *peers.Manager) *ShrexGetter {
+func NewShrexGetter(
+	edsClient *shrexeds.Client,
+	ndClient *shrexnd.Client,
+	sampleClient *shrexsample.Client,
+	peerManager *peers.Manager,
+	hedge HedgeParameters,
+) *ShrexGetter {
 	return &ShrexGetter{
 		edsClient:         edsClient,
 		ndClient:          ndClient,
+		sampleClient:      sampleClient,
 		peerManager:       peerManager,
 		minRequestTimeout: defaultMinRequestTimeout,
 		minAttemptsCount:  defaultMinAttemptsCount,
+		hedge:             hedge,
 	}
 }
 
@@ -118,11 +157,35 @@ func (sg *ShrexGetter) Stop(ctx context.Context) error {
 	return sg.peerManager.Stop(ctx)
 }
 
-func (sg *ShrexGetter) GetShare(context.Context, *share.Root, int, int) (share.Share, error) {
-	return nil, fmt.Errorf("getter/shrex: GetShare %w", errOperationNotSupported)
+func (sg *ShrexGetter) GetShare(ctx context.Context, root *share.Root, row, col int) (share.Share, error) {
+	shares, err := sg.GetSamples(ctx, root, []share.Coordinate{{Row: row, Col: col}})
+	if err != nil {
+		return nil, err
+	}
+	return shares[0], nil
+}
+
+func (sg *ShrexGetter) GetRow(context.Context, *share.Root, int, rsmt2d.Axis) (*share.AxisShares, error) {
+	return nil, fmt.Errorf("getter/shrex: GetRow %w", errOperationNotSupported)
+}
+
+func (sg *ShrexGetter) GetRange(context.Context, *share.Root, int, int) (share.RangeShares, error) {
+	return nil, fmt.Errorf("getter/shrex: GetRange %w", errOperationNotSupported)
 }
 
 func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
+	if sg.hedge.Enabled() {
+		return raceRequest(ctx, sg, root, hedgedRequest[*rsmt2d.ExtendedDataSquare]{
+			name: "eds",
+			do: func(ctx context.Context, peerID peer.ID) (*rsmt2d.ExtendedDataSquare, error) {
+				return sg.edsClient.RequestEDS(ctx, root.Hash(), peerID)
+			},
+			size:          edsSize,
+			synced:        true,
+			recordAttempt: sg.metrics.recordEDSAttempt,
+		})
+	}
+
 	var (
 		attempt int
 		err     error
@@ -148,6 +211,7 @@ func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.Ex
 		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
 		eds, getErr := sg.edsClient.RequestEDS(reqCtx, root.Hash(), peer)
 		cancel()
+		sg.observeRequest(ctx, peer, reqStart, edsSize(eds), getErr)
 		switch {
 		case getErr == nil:
 			setStatus(peers.ResultSynced)
@@ -181,6 +245,19 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 	root *share.Root,
 	id namespace.ID,
 ) (share.NamespacedShares, error) {
+	if sg.hedge.Enabled() {
+		return raceRequest(ctx, sg, root, hedgedRequest[share.NamespacedShares]{
+			name: "nd",
+			do: func(ctx context.Context, peerID peer.ID) (share.NamespacedShares, error) {
+				return sg.ndClient.RequestND(ctx, root, id, peerID)
+			},
+			size: func(nd share.NamespacedShares) int {
+				return len(nd.Flatten()) * share.Size
+			},
+			recordAttempt: sg.metrics.recordNDAttempt,
+		})
+	}
+
 	var (
 		attempt int
 		err     error
@@ -206,6 +283,7 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
 		nd, getErr := sg.ndClient.RequestND(reqCtx, root, id, peer)
 		cancel()
+		sg.observeRequest(ctx, peer, reqStart, len(nd.Flatten())*share.Size, getErr)
 		switch {
 		case getErr == nil:
 			setStatus(peers.ResultNoop)
@@ -233,3 +311,274 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 			"finished (s)", time.Since(reqStart))
 	}
 }
+
+// GetSharesByNamespaces requests NamespacedShares of multiple namespaces from a single peer at a
+// time, retrying with other peers on failures.
+func (sg *ShrexGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	ids []namespace.ID,
+) (share.NamespacedSharesMap, error) {
+	ids = uniqueNamespaces(ids)
+	if len(ids) > shrexnd.MaxNamespacesPerRequest {
+		return nil, fmt.Errorf("getter/shrex: amount of namespace IDs must not exceed %d, got %d",
+			shrexnd.MaxNamespacesPerRequest, len(ids))
+	}
+	if err := verifyNIDsSize(ids); err != nil {
+		return nil, fmt.Errorf("getter/shrex: invalid namespace IDs: %w", err)
+	}
+
+	var (
+		attempt int
+		err     error
+	)
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		attempt++
+		start := time.Now()
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+		if getErr != nil {
+			err = errors.Join(err, getErr)
+			log.Debugw("nd-batch: couldn't find peer",
+				"hash", root.String(),
+				"err", getErr,
+				"finished (s)", time.Since(start))
+			sg.metrics.recordNDAttempt(attempt, false)
+			return nil, fmt.Errorf("getter/shrex: %w", err)
+		}
+
+		reqStart := time.Now()
+		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
+		nd, getErr := sg.ndClient.RequestNDBatch(reqCtx, root, ids, peer)
+		cancel()
+		var size int
+		for _, shares := range nd {
+			size += len(shares.Flatten()) * share.Size
+		}
+		sg.observeRequest(ctx, peer, reqStart, size, getErr)
+		switch {
+		case getErr == nil:
+			setStatus(peers.ResultNoop)
+			sg.metrics.recordNDAttempt(attempt, true)
+			return nd, nil
+		case errors.Is(getErr, context.DeadlineExceeded),
+			errors.Is(getErr, context.Canceled):
+		case errors.Is(getErr, p2p.ErrNotFound):
+			getErr = share.ErrNotFound
+			setStatus(peers.ResultCooldownPeer)
+		case errors.Is(getErr, p2p.ErrInvalidResponse):
+			setStatus(peers.ResultBlacklistPeer)
+		default:
+			setStatus(peers.ResultCooldownPeer)
+		}
+
+		if !ErrorContains(err, getErr) {
+			err = errors.Join(err, getErr)
+		}
+		log.Debugw("nd-batch: request failed",
+			"hash", root.String(),
+			"peer", peer.String(),
+			"attempt", attempt,
+			"namespaces", len(ids),
+			"err", getErr,
+			"finished (s)", time.Since(reqStart))
+	}
+}
+
+// GetSharesByNamespaceStream opens the stream of NamespacedShares rows with a single peer at a
+// time, retrying with other peers until one of them starts streaming. Peers found misbehaving while
+// streaming are blacklisted.
+func (sg *ShrexGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	root *share.Root,
+	id namespace.ID,
+) (share.NamespacedRowIterator, error) {
+	var (
+		attempt int
+		err     error
+	)
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		attempt++
+		start := time.Now()
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+		if getErr != nil {
+			err = errors.Join(err, getErr)
+			log.Debugw("nd-stream: couldn't find peer",
+				"hash", root.String(),
+				"err", getErr,
+				"finished (s)", time.Since(start))
+			sg.metrics.recordNDAttempt(attempt, false)
+			return nil, fmt.Errorf("getter/shrex: %w", err)
+		}
+
+		reqStart := time.Now()
+		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
+		it, getErr := sg.ndClient.RequestNDStream(reqCtx, root, id, peer)
+		cancel()
+		// the rows are not read yet, so only the latency of opening the stream is known
+		sg.observeRequest(ctx, peer, reqStart, 0, getErr)
+		switch {
+		case getErr == nil:
+			setStatus(peers.ResultNoop)
+			sg.metrics.recordNDAttempt(attempt, true)
+			return &peerRowIterator{NamespacedRowIterator: it, setStatus: setStatus}, nil
+		case errors.Is(getErr, context.DeadlineExceeded),
+			errors.Is(getErr, context.Canceled):
+		case errors.Is(getErr, p2p.ErrNotFound):
+			getErr = share.ErrNotFound
+			setStatus(peers.ResultCooldownPeer)
+		case errors.Is(getErr, p2p.ErrInvalidResponse):
+			setStatus(peers.ResultBlacklistPeer)
+		default:
+			setStatus(peers.ResultCooldownPeer)
+		}
+
+		if !ErrorContains(err, getErr) {
+			err = errors.Join(err, getErr)
+		}
+		log.Debugw("nd-stream: request failed",
+			"hash", root.String(),
+			"peer", peer.String(),
+			"attempt", attempt,
+			"err", getErr,
+			"finished (s)", time.Since(reqStart))
+	}
+}
+
+// peerRowIterator reports the failures of reading the rows streamed by a peer to the peer manager.
+type peerRowIterator struct {
+	share.NamespacedRowIterator
+	setStatus peers.DoneFunc
+}
+
+func (it *peerRowIterator) Next(ctx context.Context) (share.NamespacedRow, error) {
+	row, err := it.NamespacedRowIterator.Next(ctx)
+	switch {
+	case err == nil,
+		errors.Is(err, io.EOF),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+	case errors.Is(err, p2p.ErrInvalidResponse):
+		it.setStatus(peers.ResultBlacklistPeer)
+	default:
+		it.setStatus(peers.ResultCooldownPeer)
+	}
+	return row, err
+}
+
+// GetSamples requests the shares at the given coordinates from a single peer at a time, retrying
+// with other peers on failures.
+func (sg *ShrexGetter) GetSamples(
+	ctx context.Context,
+	root *share.Root,
+	coords []share.Coordinate,
+) ([]share.Share, error) {
+	if len(coords) == 0 || len(coords) > shrexsample.MaxSamplesPerRequest {
+		return nil, fmt.Errorf("getter/shrex: amount of coordinates must be within [1, %d], got %d",
+			shrexsample.MaxSamplesPerRequest, len(coords))
+	}
+	for _, coord := range coords {
+		err := share.ValidateAxis(root, coord.Row, rsmt2d.Row)
+		if err == nil {
+			err = share.ValidateAxis(root, coord.Col, rsmt2d.Col)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getter/shrex: %w", err)
+		}
+	}
+
+	var (
+		attempt int
+		err     error
+	)
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		attempt++
+		start := time.Now()
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+		if getErr != nil {
+			err = errors.Join(err, getErr)
+			log.Debugw("sample: couldn't find peer",
+				"hash", root.String(),
+				"err", getErr,
+				"finished (s)", time.Since(start))
+			sg.metrics.recordSampleAttempt(attempt, false)
+			return nil, fmt.Errorf("getter/shrex: %w", err)
+		}
+
+		reqStart := time.Now()
+		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
+		shares, getErr := sg.sampleClient.RequestSamples(reqCtx, root, coords, peer)
+		cancel()
+		sg.observeRequest(ctx, peer, reqStart, len(shares)*share.Size, getErr)
+		switch {
+		case getErr == nil:
+			setStatus(peers.ResultNoop)
+			sg.metrics.recordSampleAttempt(attempt, true)
+			setSource(ctx, peer.String())
+			return shares, nil
+		case errors.Is(getErr, context.DeadlineExceeded),
+			errors.Is(getErr, context.Canceled):
+		case errors.Is(getErr, p2p.ErrNotFound):
+			getErr = share.ErrNotFound
+			setStatus(peers.ResultCooldownPeer)
+		case errors.Is(getErr, p2p.ErrInvalidResponse):
+			setStatus(peers.ResultBlacklistPeer)
+		default:
+			setStatus(peers.ResultCooldownPeer)
+		}
+
+		if !ErrorContains(err, getErr) {
+			err = errors.Join(err, getErr)
+		}
+		log.Debugw("sample: request failed",
+			"hash", root.String(),
+			"peer", peer.String(),
+			"attempt", attempt,
+			"samples", len(coords),
+			"err", getErr,
+			"finished (s)", time.Since(reqStart))
+	}
+}
+
+// edsSize returns the amount of bytes transferred to retrieve the EDS.
+func edsSize(eds *rsmt2d.ExtendedDataSquare) int {
+	if eds == nil {
+		return 0
+	}
+	// only the original data square is transferred
+	return int(eds.Width()*eds.Width()/4) * share.Size
+}
+
+// observeRequest reports the outcome of the request made to the peer to the peer manager, so that
+// it can score the peer.
+func (sg *ShrexGetter) observeRequest(
+	ctx context.Context,
+	peerID peer.ID,
+	reqStart time.Time,
+	size int,
+	err error,
+) {
+	var outcome peers.RequestOutcome
+	switch {
+	case err == nil:
+		outcome = peers.OutcomeSuccess
+	case ctx.Err() != nil, errors.Is(err, context.Canceled):
+		// the request was interrupted by the caller, which tells nothing about the peer
+		return
+	case errors.Is(err, context.DeadlineExceeded):
+		outcome = peers.OutcomeTimeout
+	case errors.Is(err, p2p.ErrNotFound):
+		outcome = peers.OutcomeNotFound
+	default:
+		outcome = peers.OutcomeFailure
+	}
+	sg.peerManager.ObserveRequest(peerID, outcome, time.Since(reqStart), size)
+}
@@ -2,6 +2,7 @@ package getters
 
 import (
 	"context"
+	"io"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	ds_sync "github.com/ipfs/go-datastore/sync"
 	routinghelpers "github.com/libp2p/go-libp2p-routing-helpers"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
 	routingdisc "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
@@ -27,6 +29,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexsample"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 )
 
@@ -47,12 +50,13 @@ func TestShrexGetter(t *testing.T) {
 
 	ndClient, _ := newNDClientServer(ctx, t, edsStore, srvHost, clHost)
 	edsClient, _ := newEDSClientServer(ctx, t, edsStore, srvHost, clHost)
+	sampleClient, _ := newSampleClientServer(ctx, t, edsStore, srvHost, clHost)
 
 	// create shrex Getter
 	sub := new(headertest.Subscriber)
 	peerManager, err := testManager(ctx, clHost, sub)
 	require.NoError(t, err)
-	getter := NewShrexGetter(edsClient, ndClient, peerManager)
+	getter := NewShrexGetter(edsClient, ndClient, sampleClient, peerManager, DefaultHedgeParameters())
 	require.NoError(t, getter.Start(ctx))
 
 	t.Run("ND_Available", func(t *testing.T) {
@@ -72,6 +76,74 @@ func TestShrexGetter(t *testing.T) {
 		require.NoError(t, got.Verify(&dah, nID))
 	})
 
+	t.Run("ND_Stream_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data with the namespace spanning multiple rows
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		it, err := getter.GetSharesByNamespaceStream(ctx, &dah, nID)
+		require.NoError(t, err)
+		rowRoots := share.RowRootsByNamespace(&dah, nID)
+		require.Len(t, rowRoots, 2)
+		for _, rowRoot := range rowRoots {
+			row, err := it.Next(ctx)
+			require.NoError(t, err)
+			require.True(t, row.Verify(rowRoot, nID))
+		}
+		_, err = it.Next(ctx)
+		require.ErrorIs(t, err, io.EOF)
+		require.NoError(t, it.Close())
+	})
+
+	t.Run("ND_Absent", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, nID, dah := randomEDSWithAbsentNamespace(t, 4)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		got, err := getter.GetSharesByNamespace(ctx, &dah, nID)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Empty(t, got.Flatten())
+		require.True(t, got[0].Proof.IsOfAbsence())
+		require.NoError(t, got.Verify(&dah, nID))
+	})
+
+	t.Run("ND_Batch_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, nID, dah := randomEDSWithDoubledNamespace(t, 4)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		firstNID := namespace.ID(eds.GetCell(0, 0)[:share.NamespaceSize])
+		absentNID := namespace.ID(make([]byte, share.NamespaceSize))
+		got, err := getter.GetSharesByNamespaces(ctx, &dah, []namespace.ID{firstNID, nID, absentNID})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.NoError(t, got.Get(firstNID).Verify(&dah, firstNID))
+		require.NoError(t, got.Get(nID).Verify(&dah, nID))
+		require.Len(t, got.Get(nID).Flatten(), 2)
+	})
+
 	t.Run("ND_err_not_found", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		t.Cleanup(cancel)
@@ -133,6 +205,122 @@ func TestShrexGetter(t *testing.T) {
 		_, err := getter.GetEDS(ctx, &dah)
 		require.ErrorIs(t, err, share.ErrNotFound)
 	})
+
+	t.Run("Samples_Available", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, dah, _ := generateTestEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		coords := []share.Coordinate{{Row: 0, Col: 0}, {Row: 3, Col: 5}, {Row: 7, Col: 7}}
+		got, err := getter.GetSamples(ctx, &dah, coords)
+		require.NoError(t, err)
+		for i, coord := range coords {
+			require.Equal(t, eds.GetCell(uint(coord.Row), uint(coord.Col)), got[i])
+		}
+
+		sh, err := getter.GetShare(ctx, &dah, 2, 1)
+		require.NoError(t, err)
+		require.Equal(t, eds.GetCell(2, 1), sh)
+	})
+
+	t.Run("Samples_err_not_found", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		_, dah, _ := generateTestEDS(t)
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		_, err := getter.GetShare(ctx, &dah, 0, 0)
+		require.ErrorIs(t, err, share.ErrNotFound)
+	})
+}
+
+func TestShrexGetter_Hedged(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	// create test net with a peer that never responds
+	net, err := mocknet.FullMeshConnected(3)
+	require.NoError(t, err)
+	clHost, srvHost, slowHost := net.Hosts()[0], net.Hosts()[1], net.Hosts()[2]
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	ndClient, _ := newNDClientServer(ctx, t, edsStore, srvHost, clHost)
+	slowServer, err := shrexnd.NewServer(shrexnd.DefaultParameters(), slowHost, edsStore, blockingGetter{})
+	require.NoError(t, err)
+	require.NoError(t, slowServer.Start(ctx))
+	t.Cleanup(func() {
+		_ = slowServer.Stop(ctx)
+	})
+
+	eds, dah, nID := generateTestEDS(t)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+
+	tests := []struct {
+		name  string
+		hedge HedgeParameters
+	}{
+		{name: "delayed", hedge: HedgeParameters{MaxParallel: 2, Delay: time.Millisecond * 50}},
+		{name: "fan out", hedge: HedgeParameters{MaxParallel: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+			t.Cleanup(cancel)
+
+			peerManager, err := testManager(ctx, clHost, new(headertest.Subscriber))
+			require.NoError(t, err)
+			getter := NewShrexGetter(nil, ndClient, nil, peerManager, tt.hedge)
+			require.NoError(t, getter.Start(ctx))
+			t.Cleanup(func() {
+				_ = getter.Stop(ctx)
+			})
+
+			// the slow peer is requested first
+			for _, peerID := range []peer.ID{slowHost.ID(), srvHost.ID()} {
+				peerManager.Validate(ctx, peerID, shrexsub.Notification{
+					DataHash: dah.Hash(),
+					Height:   1,
+				})
+			}
+
+			// without hedging, the request would wait for the slow peer until the context is done
+			start := time.Now()
+			got, err := getter.GetSharesByNamespace(ctx, &dah, nID)
+			require.NoError(t, err)
+			require.NoError(t, got.Verify(&dah, nID))
+			require.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+// blockingGetter blocks retrieval of shares by namespace until the context is done.
+type blockingGetter struct {
+	share.Getter
+}
+
+func (blockingGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	_ *share.Root,
+	_ namespace.ID,
+) (share.NamespacedRowIterator, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
 }
 
 func newStore(t *testing.T) (*eds.Store, error) {
@@ -159,6 +347,7 @@ func testManager(ctx context.Context, host host.Host, headerSub libhead.Subscrib
 
 	disc := discovery.NewDiscovery(nil,
 		routingdisc.NewRoutingDiscovery(routinghelpers.Null{}),
+		discovery.FullNodesTag,
 		discovery.WithPeersLimit(10),
 		discovery.WithAdvertiseInterval(time.Second),
 	)
@@ -171,8 +360,10 @@ func testManager(ctx context.Context, host host.Host, headerSub libhead.Subscrib
 		headerSub,
 		shrexSub,
 		disc,
+		nil,
 		host,
 		connGater,
+		nil,
 	)
 	return manager, err
 }
@@ -214,3 +405,22 @@ func newEDSClientServer(ctx context.Context, t *testing.T, edsStore *eds.Store,
 	require.NoError(t, err)
 	return client, server
 }
+
+func newSampleClientServer(ctx context.Context, t *testing.T, edsStore *eds.Store, srvHost, clHost host.Host,
+) (*shrexsample.Client, *shrexsample.Server) {
+	params := shrexsample.DefaultParameters()
+
+	// create server and register handler
+	server, err := shrexsample.NewServer(params, srvHost, edsStore)
+	require.NoError(t, err)
+	require.NoError(t, server.Start(ctx))
+
+	t.Cleanup(func() {
+		_ = server.Stop(ctx)
+	})
+
+	// create client and connect it to server
+	client, err := shrexsample.NewClient(params, clHost)
+	require.NoError(t, err)
+	return client, server
+}
@@ -0,0 +1,49 @@
+package getters
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// sourceBitswap is reported for shares retrieved from the bitswap network, as the serving peer
+	// is not known.
+	sourceBitswap = "bitswap"
+	// sourceLocal is reported for shares retrieved from the local EDS store.
+	sourceLocal = "local"
+)
+
+var sourceKey = &Source{}
+
+// Source is a slot that can optionally be passed by context to the share.Getter methods using
+// WithSource, where the getters report the source that served the request. For shrex, the source
+// is the ID of the serving peer.
+type Source struct {
+	lk     sync.Mutex
+	source string
+}
+
+// WithSource stores an empty Source in the context, so that the share.Getter serving the request
+// reports itself in it.
+func WithSource(ctx context.Context) (context.Context, *Source) {
+	src := &Source{}
+	return context.WithValue(ctx, sourceKey, src), src
+}
+
+// String returns the reported source or an empty string if none was reported.
+func (s *Source) String() string {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	return s.source
+}
+
+// setSource reports the source of the request, if the context carries a Source.
+func setSource(ctx context.Context, source string) {
+	s, ok := ctx.Value(sourceKey).(*Source)
+	if !ok {
+		return
+	}
+	s.lk.Lock()
+	s.source = source
+	s.lk.Unlock()
+}
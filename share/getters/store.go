@@ -66,6 +66,7 @@ func (sg *StoreGetter) GetShare(ctx context.Context, dah *share.Root, row, col i
 		return nil, fmt.Errorf("getter/store: failed to retrieve share: %w", err)
 	}
 
+	setSource(ctx, sourceLocal)
 	return s, nil
 }
 
@@ -130,3 +131,162 @@ func (sg *StoreGetter) GetSharesByNamespace(
 	}
 	return shares, nil
 }
+
+// GetSharesByNamespaces gets the shares within any of the given namespace IDs from the EDS store
+// through the corresponding CAR-level blockstore.
+func (sg *StoreGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	nIDs []namespace.ID,
+) (shares share.NamespacedSharesMap, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("namespaces", len(nIDs)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = verifyNIDsSize(nIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: invalid namespace IDs: %w", err)
+	}
+
+	bs, err := sg.store.CARBlockstore(ctx, root.Hash())
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
+	}
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	shares, err = collectSharesByNamespaces(ctx, blockGetter, root, nIDs)
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve shares by namespaces: %w", err)
+	}
+	return shares, nil
+}
+
+// GetSharesByNamespaceStream gets the shares within the given namespace ID row-by-row from the EDS
+// store through the corresponding CAR-level blockstore.
+func (sg *StoreGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	root *share.Root,
+	nID namespace.ID,
+) (it share.NamespacedRowIterator, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-shares-by-namespace-stream", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.String("nID", nID.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = verifyNIDSize(nID)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: invalid namespace ID: %w", err)
+	}
+
+	bs, err := sg.store.CARBlockstore(ctx, root.Hash())
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
+	}
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	it, err = newNamespacedRowIterator(blockGetter, root, nID)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve shares by namespace: %w", err)
+	}
+	return it, nil
+}
+
+// GetRow gets all the shares of the given row or column from the EDS store through the
+// corresponding CAR-level blockstore.
+func (sg *StoreGetter) GetRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (shares *share.AxisShares, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-row", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("idx", idx),
+		attribute.Stringer("axis", axis),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = share.ValidateAxis(root, idx, axis)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: %w", err)
+	}
+
+	bs, err := sg.store.CARBlockstore(ctx, root.Hash())
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
+	}
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	shares, err = collectAxis(ctx, blockGetter, root, idx, axis, 0, len(root.RowsRoots))
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve %s: %w", axis, err)
+	}
+	return shares, nil
+}
+
+// GetRange gets the shares of the original data square within the given range from the EDS store
+// through the corresponding CAR-level blockstore.
+func (sg *StoreGetter) GetRange(
+	ctx context.Context,
+	root *share.Root,
+	start, end int,
+) (shares share.RangeShares, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-range", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("start", start),
+		attribute.Int("end", end),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = share.ValidateRange(root, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: %w", err)
+	}
+
+	bs, err := sg.store.CARBlockstore(ctx, root.Hash())
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
+	}
+
+	// wrap the read-only CAR blockstore in a getter
+	blockGetter := eds.NewBlockGetter(bs)
+	shares, err = collectRange(ctx, blockGetter, root, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("getter/store: failed to retrieve range: %w", err)
+	}
+	return shares, nil
+}
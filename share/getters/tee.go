@@ -83,3 +83,70 @@ func (tg *TeeGetter) GetSharesByNamespace(
 
 	return tg.getter.GetSharesByNamespace(ctx, root, id)
 }
+
+func (tg *TeeGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	ids []namespace.ID,
+) (shares share.NamespacedSharesMap, err error) {
+	ctx, span := tracer.Start(ctx, "tee/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("namespaces", len(ids)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	return tg.getter.GetSharesByNamespaces(ctx, root, ids)
+}
+
+func (tg *TeeGetter) GetSharesByNamespaceStream(
+	ctx context.Context,
+	root *share.Root,
+	id namespace.ID,
+) (it share.NamespacedRowIterator, err error) {
+	ctx, span := tracer.Start(ctx, "tee/get-shares-by-namespace-stream", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.String("nID", id.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	return tg.getter.GetSharesByNamespaceStream(ctx, root, id)
+}
+
+func (tg *TeeGetter) GetRow(
+	ctx context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (shares *share.AxisShares, err error) {
+	ctx, span := tracer.Start(ctx, "tee/get-row", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("idx", idx),
+		attribute.Stringer("axis", axis),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	return tg.getter.GetRow(ctx, root, idx, axis)
+}
+
+func (tg *TeeGetter) GetRange(
+	ctx context.Context,
+	root *share.Root,
+	start, end int,
+) (shares share.RangeShares, err error) {
+	ctx, span := tracer.Start(ctx, "tee/get-range", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("start", start),
+		attribute.Int("end", end),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	return tg.getter.GetRange(ctx, root, start, end)
+}
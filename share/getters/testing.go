@@ -22,7 +22,7 @@ func TestGetter(t *testing.T) (share.Getter, *share.Root) {
 }
 
 // SingleEDSGetter contains a single EDS where data is retrieved from.
-// Its primary use is testing, and GetSharesByNamespace is not supported.
+// Its primary use is testing, and GetSharesByNamespace and its variants are not supported.
 type SingleEDSGetter struct {
 	EDS *rsmt2d.ExtendedDataSquare
 }
@@ -51,6 +51,70 @@ func (seg *SingleEDSGetter) GetSharesByNamespace(context.Context, *share.Root, n
 	panic("SingleEDSGetter: GetSharesByNamespace is not implemented")
 }
 
+// GetSharesByNamespaces is not supported the same way as GetSharesByNamespace.
+func (seg *SingleEDSGetter) GetSharesByNamespaces(context.Context, *share.Root, []namespace.ID,
+) (share.NamespacedSharesMap, error) {
+	panic("SingleEDSGetter: GetSharesByNamespaces is not implemented")
+}
+
+// GetSharesByNamespaceStream is not supported the same way as GetSharesByNamespace.
+func (seg *SingleEDSGetter) GetSharesByNamespaceStream(context.Context, *share.Root, namespace.ID,
+) (share.NamespacedRowIterator, error) {
+	panic("SingleEDSGetter: GetSharesByNamespaceStream is not implemented")
+}
+
+// GetRow returns the shares of a row or column from a kept EDS if the correct root is given.
+func (seg *SingleEDSGetter) GetRow(
+	_ context.Context,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+) (*share.AxisShares, error) {
+	err := seg.checkRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	err = share.ValidateAxis(root, idx, axis)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := seg.EDS.Row(uint(idx))
+	if axis == rsmt2d.Col {
+		shares = seg.EDS.Col(uint(idx))
+	}
+	return share.ProveAxis(root, axis, idx, shares, 0, len(shares))
+}
+
+// GetRange returns the shares within the range of the original data square from a kept EDS if the
+// correct root is given.
+func (seg *SingleEDSGetter) GetRange(_ context.Context, root *share.Root, start, end int) (share.RangeShares, error) {
+	err := seg.checkRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	err = share.ValidateRange(root, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares share.RangeShares
+	share.RangeRows(root, start, end, func(row, colStart, colEnd int) {
+		if err != nil {
+			return
+		}
+		var rowShares *share.AxisShares
+		rowShares, err = share.ProveAxis(root, rsmt2d.Row, row, seg.EDS.Row(uint(row)), colStart, colEnd)
+		if err == nil {
+			shares = append(shares, *rowShares)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
 func (seg *SingleEDSGetter) checkRoot(root *share.Root) error {
 	dah := da.NewDataAvailabilityHeader(seg.EDS)
 	if !root.Equals(&dah) {
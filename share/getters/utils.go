@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/ipfs/go-blockservice"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/celestiaorg/nmt"
 	"github.com/celestiaorg/nmt/namespace"
+	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
@@ -29,14 +32,44 @@ var (
 	errOperationNotSupported = errors.New("operation is not supported")
 )
 
+// getSamples gets the shares at the given coordinates using the share.SampleGetter, if the given
+// getter implements it, or by requesting every share concurrently otherwise.
+func getSamples(
+	ctx context.Context,
+	getter share.Getter,
+	root *share.Root,
+	coords []share.Coordinate,
+) ([]share.Share, error) {
+	if sg, ok := getter.(share.SampleGetter); ok {
+		return sg.GetSamples(ctx, root, coords)
+	}
+
+	shares := make([]share.Share, len(coords))
+	errGroup, ctx := errgroup.WithContext(ctx)
+	for i, coord := range coords {
+		i, coord := i, coord
+		errGroup.Go(func() error {
+			sh, err := getter.GetShare(ctx, root, coord.Row, coord.Col)
+			if err != nil {
+				return err
+			}
+			shares[i] = sh
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
 // filterRootsByNamespace returns the row roots from the given share.Root that contain the passed
 // namespace ID.
 func filterRootsByNamespace(root *share.Root, nID namespace.ID) []cid.Cid {
-	rowRootCIDs := make([]cid.Cid, 0, len(root.RowsRoots))
-	for _, row := range root.RowsRoots {
-		if !nID.Less(nmt.MinNamespace(row, nID.Size())) && nID.LessOrEqual(nmt.MaxNamespace(row, nID.Size())) {
-			rowRootCIDs = append(rowRootCIDs, ipld.MustCidFromNamespacedSha256(row))
-		}
+	rowRoots := share.RowRootsByNamespace(root, nID)
+	rowRootCIDs := make([]cid.Cid, 0, len(rowRoots))
+	for _, row := range rowRoots {
+		rowRootCIDs = append(rowRootCIDs, ipld.MustCidFromNamespacedSha256(row))
 	}
 	return rowRootCIDs
 }
@@ -57,18 +90,98 @@ func collectSharesByNamespace(
 		utils.SetStatusAndEnd(span, err)
 	}()
 
-	rootCIDs := filterRootsByNamespace(root, nID)
+	return collectNamespaceRows(ctx, bg, filterRootsByNamespace(root, nID), nID, len(root.RowsRoots))
+}
+
+// filterRootsByNamespaces returns the row roots from the given share.Root that contain any of the
+// passed namespace IDs, grouped by the hex encoded namespace ID. Unlike calling
+// filterRootsByNamespace for every namespace ID, it walks the roots only once.
+func filterRootsByNamespaces(root *share.Root, nIDs []namespace.ID) map[string][]cid.Cid {
+	rowRootCIDs := make(map[string][]cid.Cid, len(nIDs))
+	for _, row := range root.RowsRoots {
+		var rowCID cid.Cid
+		for _, nID := range nIDs {
+			if nID.Less(nmt.MinNamespace(row, nID.Size())) || !nID.LessOrEqual(nmt.MaxNamespace(row, nID.Size())) {
+				continue
+			}
+			if !rowCID.Defined() {
+				rowCID = ipld.MustCidFromNamespacedSha256(row)
+			}
+			rowRootCIDs[nID.String()] = append(rowRootCIDs[nID.String()], rowCID)
+		}
+	}
+	return rowRootCIDs
+}
+
+// collectSharesByNamespaces collects NamespaceShares within any of the given namespace IDs from
+// the given share.Root. The namespaces that are not found are omitted from the result.
+func collectSharesByNamespaces(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	nIDs []namespace.ID,
+) (shares share.NamespacedSharesMap, err error) {
+	ctx, span := tracer.Start(ctx, "collect-shares-by-namespaces", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("namespaces", len(nIDs)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	nIDs = uniqueNamespaces(nIDs)
+	rootCIDs := filterRootsByNamespaces(root, nIDs)
+
+	var lk sync.Mutex
+	shares = make(share.NamespacedSharesMap, len(rootCIDs))
+	errGroup, ctx := errgroup.WithContext(ctx)
+	for _, nID := range nIDs {
+		nID := nID
+		errGroup.Go(func() error {
+			rows, err := collectNamespaceRows(ctx, bg, rootCIDs[nID.String()], nID, len(root.RowsRoots))
+			if errors.Is(err, share.ErrNotFound) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			lk.Lock()
+			defer lk.Unlock()
+			shares[nID.String()] = rows
+			return nil
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, share.ErrNotFound
+	}
+	return shares, nil
+}
+
+// collectNamespaceRows collects the shares within the given namespace ID from all the given row
+// roots.
+func collectNamespaceRows(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	rootCIDs []cid.Cid,
+	nID namespace.ID,
+	width int,
+) (share.NamespacedShares, error) {
 	if len(rootCIDs) == 0 {
 		return nil, share.ErrNotFound
 	}
 
 	errGroup, ctx := errgroup.WithContext(ctx)
-	shares = make([]share.NamespacedRow, len(rootCIDs))
+	shares := make([]share.NamespacedRow, len(rootCIDs))
 	for i, rootCID := range rootCIDs {
 		// shadow loop variables, to ensure correct values are captured
 		i, rootCID := i, rootCID
 		errGroup.Go(func() error {
-			row, proof, err := share.GetSharesByNamespace(ctx, bg, rootCID, nID, len(root.RowsRoots))
+			row, proof, err := share.GetSharesByNamespace(ctx, bg, rootCID, nID, width)
 			shares[i] = share.NamespacedRow{
 				Shares: row,
 				Proof:  proof,
@@ -83,12 +196,150 @@ func collectSharesByNamespace(
 	if err := errGroup.Wait(); err != nil {
 		return nil, err
 	}
+	return shares, nil
+}
+
+// uniqueNamespaces returns the given namespace IDs without duplicates.
+func uniqueNamespaces(nIDs []namespace.ID) []namespace.ID {
+	seen := make(map[string]struct{}, len(nIDs))
+	unique := make([]namespace.ID, 0, len(nIDs))
+	for _, nID := range nIDs {
+		if _, ok := seen[nID.String()]; ok {
+			continue
+		}
+		seen[nID.String()] = struct{}{}
+		unique = append(unique, nID)
+	}
+	return unique
+}
+
+// namespacedRowIterator implements share.NamespacedRowIterator retrieving the rows within the
+// namespace ID one by one, as they are requested.
+type namespacedRowIterator struct {
+	bg       blockservice.BlockGetter
+	nID      namespace.ID
+	width    int
+	rootCIDs []cid.Cid
 
-	// return ErrNotFound if no shares are found for namespaceID
-	if len(rootCIDs) == 1 && len(shares[0].Shares) == 0 {
+	next int
+}
+
+// newNamespacedRowIterator creates a share.NamespacedRowIterator over the rows within the given
+// namespace ID of the given share.Root. Similarly to collectSharesByNamespace, it returns
+// share.ErrNotFound if no row may contain the namespace.
+func newNamespacedRowIterator(
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	nID namespace.ID,
+) (*namespacedRowIterator, error) {
+	it := &namespacedRowIterator{
+		bg:       bg,
+		nID:      nID,
+		width:    len(root.RowsRoots),
+		rootCIDs: filterRootsByNamespace(root, nID),
+	}
+	if len(it.rootCIDs) == 0 {
 		return nil, share.ErrNotFound
 	}
+	return it, nil
+}
+
+func (it *namespacedRowIterator) Next(ctx context.Context) (share.NamespacedRow, error) {
+	if it.next == len(it.rootCIDs) {
+		return share.NamespacedRow{}, io.EOF
+	}
+
+	rootCID := it.rootCIDs[it.next]
+	shares, proof, err := share.GetSharesByNamespace(ctx, it.bg, rootCID, it.nID, it.width)
+	if errors.Is(err, ipld.ErrNodeNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return share.NamespacedRow{}, fmt.Errorf("retrieving nID %x for row %x: %w", it.nID, rootCID, err)
+	}
+
+	it.next++
+	return share.NamespacedRow{
+		Shares: shares,
+		Proof:  proof,
+	}, nil
+}
+
+func (it *namespacedRowIterator) Close() error {
+	return nil
+}
+
+// collectAxis retrieves all the shares of the given row or column from the given share.Root and
+// proves the inclusion of the ones within [start, end) into the axis root.
+func collectAxis(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	idx int,
+	axis rsmt2d.Axis,
+	start, end int,
+) (*share.AxisShares, error) {
+	width := len(root.RowsRoots)
+	axisRoot := ipld.MustCidFromNamespacedSha256(share.AxisRoots(root, axis)[idx])
+
+	var lk sync.Mutex
+	shares := make([]share.Share, width)
+	share.GetShares(ctx, bg, axisRoot, width, func(i int, sh share.Share) {
+		lk.Lock()
+		shares[i] = sh
+		lk.Unlock()
+	})
 
+	lk.Lock()
+	defer lk.Unlock()
+	for _, sh := range shares {
+		if sh == nil {
+			// GetShares only returns before collecting all the shares if the context is done
+			return nil, fmt.Errorf("retrieving %s %d: %w", axis, idx, errors.Join(share.ErrNotFound, ctx.Err()))
+		}
+	}
+	return share.ProveAxis(root, axis, idx, shares, start, end)
+}
+
+// collectRange retrieves the shares within the range of the original data square share indexes
+// [start, end) from the given share.Root.
+func collectRange(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	start, end int,
+) (share.RangeShares, error) {
+	ctx, span := tracer.Start(ctx, "collect-range", trace.WithAttributes(
+		attribute.String("root", root.String()),
+		attribute.Int("start", start),
+		attribute.Int("end", end),
+	))
+	var err error
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	odsWidth := len(root.RowsRoots) / 2
+	firstRow := start / odsWidth
+	shares := make(share.RangeShares, (end-1)/odsWidth-firstRow+1)
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	share.RangeRows(root, start, end, func(row, colStart, colEnd int) {
+		i := row - firstRow
+		errGroup.Go(func() error {
+			rowShares, err := collectAxis(ctx, bg, root, row, rsmt2d.Row, colStart, colEnd)
+			if err != nil {
+				return err
+			}
+			shares[i] = *rowShares
+			return nil
+		})
+	})
+
+	if err = errGroup.Wait(); err != nil {
+		return nil, err
+	}
 	return shares, nil
 }
 
@@ -100,6 +351,18 @@ func verifyNIDSize(nID namespace.ID) error {
 	return nil
 }
 
+func verifyNIDsSize(nIDs []namespace.ID) error {
+	if len(nIDs) == 0 {
+		return errors.New("no namespace IDs given")
+	}
+	for _, nID := range nIDs {
+		if err := verifyNIDSize(nID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ctxWithSplitTimeout will split timeout stored in context by splitFactor and return the result if
 // it is greater than minTimeout. minTimeout == 0 will be ignored, splitFactor <= 0 will be ignored
 func ctxWithSplitTimeout(
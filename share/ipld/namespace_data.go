@@ -44,6 +44,11 @@ type NamespaceData struct {
 	bounds    fetchedBounds
 	maxShares int
 	nID       namespace.ID
+
+	// absenceLeafHash and absenceLeafPos identify the leaf the proof of absence is built for, if
+	// the namespace is not present under the root
+	absenceLeafHash []byte
+	absenceLeafPos  int
 }
 
 func NewNamespaceData(maxShares int, nID namespace.ID, options ...Option) *NamespaceData {
@@ -123,22 +128,34 @@ func (n *NamespaceData) Leaves() []ipld.Node {
 }
 
 // Proof returns proofs within the bounds in case if `WithProofs` option was passed,
-// otherwise nil will be returned.
+// otherwise nil will be returned. If the namespace is not present under the root, but is within
+// its namespace range, the proof of absence of the namespace is returned.
 func (n *NamespaceData) Proof() *nmt.Proof {
 	if n.proofs == nil {
 		return nil
 	}
 
-	// return an empty Proof if leaves are not available
-	if n.noLeaves() {
-		return &nmt.Proof{}
-	}
-
 	nodes := make([][]byte, len(n.proofs.Nodes()))
 	for i, node := range n.proofs.Nodes() {
 		nodes[i] = NamespacedSha256FromCID(node)
 	}
 
+	if n.absenceLeafHash != nil {
+		proof := nmt.NewAbsenceProof(
+			n.absenceLeafPos,
+			n.absenceLeafPos+1,
+			nodes,
+			n.absenceLeafHash,
+			NMTIgnoreMaxNamespace,
+		)
+		return &proof
+	}
+
+	// return an empty Proof if leaves are not available
+	if n.noLeaves() {
+		return &nmt.Proof{}
+	}
+
 	proof := nmt.NewInclusionProof(
 		int(n.bounds.lowest),
 		int(n.bounds.highest)+1,
@@ -153,6 +170,9 @@ func (n *NamespaceData) Proof() *nmt.Proof {
 // it can retrieve. If no shares are found, it returns error as nil. A
 // non-nil error means that only partial data is returned, because at least one share retrieval
 // failed. The following implementation is based on `GetShares`.
+//
+// If proofs are collected and no leaves are found, while the namespace is within the namespace
+// range of the root, the proof of absence of the namespace is collected instead.
 func (n *NamespaceData) CollectLeavesByNamespace(
 	ctx context.Context,
 	bGetter blockservice.BlockGetter,
@@ -170,6 +190,18 @@ func (n *NamespaceData) CollectLeavesByNamespace(
 		attribute.String("root", root.String()),
 	)
 
+	err := n.collectLeaves(ctx, bGetter, root)
+	if err != nil || n.proofs == nil || !n.noLeaves() {
+		return err
+	}
+	return n.collectAbsenceProof(ctx, bGetter, root)
+}
+
+func (n *NamespaceData) collectLeaves(
+	ctx context.Context,
+	bGetter blockservice.BlockGetter,
+	root cid.Cid,
+) error {
 	// buffer the jobs to avoid blocking, we only need as many
 	// queued as the number of shares in the second-to-last layer
 	jobs := make(chan *job, (n.maxShares+1)/2)
@@ -274,6 +306,48 @@ func (n *NamespaceData) CollectLeavesByNamespace(
 	}
 }
 
+// collectAbsenceProof collects the proof of absence of the namespace under the given root. The
+// proof is built for the leaf with the smallest namespace greater than the namespace ID, so the
+// path to it is walked, collecting the sibling nodes along the way.
+func (n *NamespaceData) collectAbsenceProof(
+	ctx context.Context,
+	bGetter blockservice.BlockGetter,
+	root cid.Cid,
+) error {
+	rootNID := NamespacedSha256FromCID(root)
+	if n.nID.Less(nmt.MinNamespace(rootNID, n.nID.Size())) ||
+		!n.nID.Less(nmt.MaxNamespace(rootNID, n.nID.Size())) {
+		// the namespace is outside the range of the root, which proves the absence on its own
+		return nil
+	}
+
+	// drop the nodes collected by the walk, as the proof is built for a different range
+	n.proofs = newProofCollector(n.maxShares)
+	id, pos := root, 0
+	for depth := 0; ; depth++ {
+		nd, err := GetNode(ctx, bGetter, id)
+		if err != nil {
+			return err
+		}
+
+		links := nd.Links()
+		if len(links) == 0 {
+			n.absenceLeafHash, n.absenceLeafPos = NamespacedSha256FromCID(id), pos
+			return nil
+		}
+
+		// the leaf is in the left subtree, if it has any namespace greater than the namespace ID
+		leftCID, rightCID := links[0].Cid, links[1].Cid
+		if n.nID.Less(nmt.MaxNamespace(NamespacedSha256FromCID(leftCID), n.nID.Size())) {
+			n.addProof(right, rightCID, depth+1)
+			id, pos = leftCID, pos*2
+			continue
+		}
+		n.addProof(left, leftCID, depth+1)
+		id, pos = rightCID, pos*2+1
+	}
+}
+
 type fetchedBounds struct {
 	lowest  int64
 	highest int64
@@ -54,6 +54,36 @@ func (mr *MockGetterMockRecorder) GetEDS(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEDS", reflect.TypeOf((*MockGetter)(nil).GetEDS), arg0, arg1)
 }
 
+// GetRange mocks base method.
+func (m *MockGetter) GetRange(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2, arg3 int) (share.RangeShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(share.RangeShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRange indicates an expected call of GetRange.
+func (mr *MockGetterMockRecorder) GetRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockGetter)(nil).GetRange), arg0, arg1, arg2, arg3)
+}
+
+// GetRow mocks base method.
+func (m *MockGetter) GetRow(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 int, arg3 rsmt2d.Axis) (*share.AxisShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*share.AxisShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRow indicates an expected call of GetRow.
+func (mr *MockGetterMockRecorder) GetRow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRow", reflect.TypeOf((*MockGetter)(nil).GetRow), arg0, arg1, arg2, arg3)
+}
+
 // GetShare mocks base method.
 func (m *MockGetter) GetShare(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2, arg3 int) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -83,3 +113,33 @@ func (mr *MockGetterMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
+
+// GetSharesByNamespaceStream mocks base method.
+func (m *MockGetter) GetSharesByNamespaceStream(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 namespace.ID) (share.NamespacedRowIterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaceStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(share.NamespacedRowIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaceStream indicates an expected call of GetSharesByNamespaceStream.
+func (mr *MockGetterMockRecorder) GetSharesByNamespaceStream(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaceStream", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespaceStream), arg0, arg1, arg2)
+}
+
+// GetSharesByNamespaces mocks base method.
+func (m *MockGetter) GetSharesByNamespaces(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 []namespace.ID) (share.NamespacedSharesMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaces", arg0, arg1, arg2)
+	ret0, _ := ret[0].(share.NamespacedSharesMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaces indicates an expected call of GetSharesByNamespaces.
+func (mr *MockGetterMockRecorder) GetSharesByNamespaces(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaces", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespaces), arg0, arg1, arg2)
+}
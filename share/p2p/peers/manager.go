@@ -9,6 +9,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -59,10 +60,18 @@ type Manager struct {
 
 	// fullNodes collects full nodes peer.ID found via discovery
 	fullNodes *pool
+	// archivalNodes collects peer.ID of full nodes keeping the whole chain history found via
+	// archival discovery. They are preferred for heights outside of the ArchivalWindow.
+	archivalNodes *pool
+	// headHeight is the height of the most recent header received from headerSub
+	headHeight atomic.Uint64
 
 	// hashes that are not in the chain
 	blacklistedHashes map[string]bool
 
+	// scores keeps the statistics of the requests made to peers. It is nil if scoring is disabled.
+	scores *peerScores
+
 	metrics *metrics
 
 	cancel context.CancelFunc
@@ -93,8 +102,10 @@ func NewManager(
 	headerSub libhead.Subscriber[*header.ExtendedHeader],
 	shrexSub *shrexsub.PubSub,
 	discovery *discovery.Discovery,
+	archival *discovery.Discovery,
 	host host.Host,
 	connGater *conngater.BasicConnectionGater,
+	ds datastore.Batching,
 ) (*Manager, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
@@ -111,28 +122,58 @@ func NewManager(
 		done:              make(chan struct{}),
 	}
 
-	s.fullNodes = newPool(s.params.PeerCooldown)
+	// peer scores are persisted only if the datastore is given
+	if s.params.ScoreHalfLife > 0 {
+		s.scores = newPeerScores(s.params.ScoreHalfLife, ds)
+	}
+
+	s.fullNodes = s.newPool()
+	s.archivalNodes = s.newPool()
+
+	discovery.WithOnPeersUpdate(s.onPeersUpdate(s.fullNodes, "full"))
+	// archival discovery is optional
+	if archival != nil {
+		archival.WithOnPeersUpdate(s.onPeersUpdate(s.archivalNodes, "archival"))
+	}
+
+	return s, nil
+}
 
-	discovery.WithOnPeersUpdate(
-		func(peerID peer.ID, isAdded bool) {
-			if isAdded {
-				if s.isBlacklistedPeer(peerID) {
-					log.Debugw("got blacklisted peer from discovery", "peer", peerID)
-					return
-				}
-				log.Debugw("added to full nodes", "peer", peerID)
-				s.fullNodes.add(peerID)
+// onPeersUpdate returns the discovery callback that keeps the given pool of discovered nodes in
+// sync with the discovery.
+func (m *Manager) onPeersUpdate(p *pool, kind string) discovery.OnUpdatedPeers {
+	return func(peerID peer.ID, isAdded bool) {
+		if isAdded {
+			if m.isBlacklistedPeer(peerID) {
+				log.Debugw("got blacklisted peer from discovery", "peer", peerID, "kind", kind)
 				return
 			}
+			log.Debugw("added to discovered nodes", "peer", peerID, "kind", kind)
+			p.add(peerID)
+			return
+		}
 
-			log.Debugw("removing peer from discovered full nodes", "peer", peerID)
-			s.fullNodes.remove(peerID)
-		})
+		log.Debugw("removing peer from discovered nodes", "peer", peerID, "kind", kind)
+		p.remove(peerID)
+	}
+}
 
-	return s, nil
+// newPool creates a pool scoring its peers, if scoring is enabled.
+func (m *Manager) newPool() *pool {
+	p := newPool(m.params.PeerCooldown)
+	if m.scores != nil {
+		p.score = m.scores.score
+	}
+	return p
 }
 
 func (m *Manager) Start(startCtx context.Context) error {
+	if m.scores != nil {
+		if err := m.scores.load(startCtx); err != nil {
+			return fmt.Errorf("loading peer scores: %w", err)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	m.cancel = cancel
 
@@ -163,15 +204,23 @@ func (m *Manager) Stop(ctx context.Context) error {
 
 	select {
 	case <-m.done:
-		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	}
+
+	if m.scores != nil {
+		if err := m.scores.save(ctx); err != nil {
+			return fmt.Errorf("saving peer scores: %w", err)
+		}
+	}
+	return nil
 }
 
 // Peer returns peer collected from shrex.Sub for given datahash if any available.
-// If there is none, it will look for full nodes collected from discovery. If there is no discovered
-// full nodes, it will wait until any peer appear in either source or timeout happen.
+// If there is none, it will look for full nodes collected from discovery, preferring archival
+// ones for datahashes of heights outside the ArchivalWindow. If there is no discovered
+// full nodes, it will wait until any peer appear in either source or timeout happen. Within every
+// source, better scored peers are preferred, if peer scoring is enabled.
 // After fetching data using given peer, caller is required to call returned DoneFunc using
 // appropriate result value
 func (m *Manager) Peer(
@@ -192,6 +241,16 @@ func (m *Manager) Peer(
 		return m.newPeer(datahash, peerID, sourceShrexSub, p.len(), 0)
 	}
 
+	// older data may already be pruned by regular full nodes, so archival ones are tried first
+	var archivalNext <-chan peer.ID
+	if m.isOutsideArchivalWindow(p) {
+		peerID, ok = m.archivalNodes.tryGet()
+		if ok {
+			return m.newPeer(datahash, peerID, sourceArchivalNodes, m.archivalNodes.len(), 0)
+		}
+		archivalNext = m.archivalNodes.next(ctx)
+	}
+
 	// if no peer for datahash is currently available, try to use full node
 	// obtained from discovery
 	peerID, ok = m.fullNodes.tryGet()
@@ -204,6 +263,8 @@ func (m *Manager) Peer(
 	select {
 	case peerID = <-p.next(ctx):
 		return m.newPeer(datahash, peerID, sourceShrexSub, p.len(), time.Since(start))
+	case peerID = <-archivalNext:
+		return m.newPeer(datahash, peerID, sourceArchivalNodes, m.archivalNodes.len(), time.Since(start))
 	case peerID = <-m.fullNodes.next(ctx):
 		return m.newPeer(datahash, peerID, sourceFullNodes, m.fullNodes.len(), time.Since(start))
 	case <-ctx.Done():
@@ -211,6 +272,22 @@ func (m *Manager) Peer(
 	}
 }
 
+// isOutsideArchivalWindow reports whether the datahash of the given pool belongs to a height
+// outside the ArchivalWindow. Datahashes of unknown heights predate the headers received by the
+// Manager and are considered to be outside the window.
+func (m *Manager) isOutsideArchivalWindow(p *syncPool) bool {
+	if m.params.ArchivalWindow == 0 {
+		return false
+	}
+
+	height := p.headerHeight.Load()
+	if height == 0 {
+		return true
+	}
+	head := m.headHeight.Load()
+	return head > height && head-height >= m.params.ArchivalWindow
+}
+
 func (m *Manager) newPeer(
 	datahash share.DataHash,
 	peerID peer.ID,
@@ -241,8 +318,11 @@ func (m *Manager) doneFunc(datahash share.DataHash, peerID peer.ID, source peerS
 			m.markPoolAsSynced(datahash.String())
 		case ResultCooldownPeer:
 			m.getOrCreatePool(datahash.String()).putOnCooldown(peerID)
-			if source == sourceFullNodes {
+			switch source {
+			case sourceFullNodes:
 				m.fullNodes.putOnCooldown(peerID)
+			case sourceArchivalNodes:
+				m.archivalNodes.putOnCooldown(peerID)
 			}
 		case ResultBlacklistPeer:
 			m.blacklistPeers(reasonMisbehave, peerID)
@@ -250,6 +330,17 @@ func (m *Manager) doneFunc(datahash share.DataHash, peerID peer.ID, source peerS
 	}
 }
 
+// ObserveRequest updates the score of the peer with the outcome of a request made to it, its
+// latency and the amount of bytes served. It should be called for every request made to a peer
+// returned from the Peer method, unless the request was canceled by the caller.
+func (m *Manager) ObserveRequest(peerID peer.ID, outcome RequestOutcome, latency time.Duration, size int) {
+	m.metrics.observeRequest(outcome, latency)
+	if m.scores == nil {
+		return
+	}
+	m.scores.observe(peerID, outcome, latency, size)
+}
+
 // subscribeHeader takes datahash from received header and validates corresponding peer pool.
 func (m *Manager) subscribeHeader(ctx context.Context, headerSub libhead.Subscription[*header.ExtendedHeader]) {
 	defer close(m.done)
@@ -264,7 +355,9 @@ func (m *Manager) subscribeHeader(ctx context.Context, headerSub libhead.Subscri
 			log.Errorw("get next header from sub", "err", err)
 			continue
 		}
-		m.validatedPool(h.DataHash.String())
+		p := m.validatedPool(h.DataHash.String())
+		p.headerHeight.Store(uint64(h.Height()))
+		m.headHeight.Store(uint64(h.Height()))
 
 		// store first header for validation purposes
 		if m.initialHeight.CompareAndSwap(0, uint64(h.Height())) {
@@ -332,7 +425,7 @@ func (m *Manager) getOrCreatePool(datahash string) *syncPool {
 	p, ok := m.pools[datahash]
 	if !ok {
 		p = &syncPool{
-			pool:      newPool(m.params.PeerCooldown),
+			pool:      m.newPool(),
 			createdAt: time.Now(),
 		}
 		m.pools[datahash] = p
@@ -352,6 +445,7 @@ func (m *Manager) blacklistPeers(reason blacklistPeerReason, peerIDs ...peer.ID)
 	}
 	for _, peerID := range peerIDs {
 		m.fullNodes.remove(peerID)
+		m.archivalNodes.remove(peerID)
 		// add peer to the blacklist, so we can't connect to it in the future.
 		err := m.connGater.BlockPeer(peerID)
 		if err != nil {
@@ -391,6 +485,12 @@ func (m *Manager) GC(ctx context.Context) {
 		if len(blacklist) > 0 {
 			m.blacklistPeers(reasonInvalidHash, blacklist...)
 		}
+
+		if m.scores != nil {
+			if err := m.scores.save(ctx); err != nil {
+				log.Warnw("saving peer scores", "err", err)
+			}
+		}
 	}
 }
 
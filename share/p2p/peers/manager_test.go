@@ -223,6 +223,76 @@ func TestManager(t *testing.T) {
 		stopManager(t, manager)
 	})
 
+	t.Run("prefer archival nodes for old heights", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		t.Cleanup(cancel)
+
+		h := testHeader()
+		headerSub := newSubLock(h, nil)
+
+		// start test manager
+		manager, err := testManager(ctx, headerSub)
+		require.NoError(t, err)
+		manager.params.ArchivalWindow = 10
+
+		// wait until header is processed by the manager
+		err = headerSub.wait(ctx, 1)
+		require.NoError(t, err)
+
+		// add peers to both full and archival nodes, imitating discovery add
+		fullPeers := []peer.ID{"full1", "full2"}
+		manager.fullNodes.add(fullPeers...)
+		archivalPeers := []peer.ID{"archival1", "archival2"}
+		manager.archivalNodes.add(archivalPeers...)
+
+		// recent heights are requested from full nodes
+		peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+		require.NoError(t, err)
+		done(ResultSynced)
+		require.Contains(t, fullPeers, peerID)
+
+		// datahash of unknown height predates the headers received and is requested from archival nodes
+		peerID, done, err = manager.Peer(ctx, share.DataHash("old"))
+		require.NoError(t, err)
+		done(ResultSynced)
+		require.Contains(t, archivalPeers, peerID)
+
+		// archival preference is disabled with zero window
+		manager.params.ArchivalWindow = 0
+		peerID, done, err = manager.Peer(ctx, share.DataHash("older"))
+		require.NoError(t, err)
+		done(ResultSynced)
+		require.Contains(t, fullPeers, peerID)
+
+		stopManager(t, manager)
+	})
+
+	t.Run("prefer better scored peers", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		t.Cleanup(cancel)
+
+		h := testHeader()
+		headerSub := newSubLock(h)
+
+		// start test manager
+		manager, err := testManager(ctx, headerSub)
+		require.NoError(t, err)
+
+		// add peers to fullnodes, imitating discovery add
+		manager.fullNodes.add("slow", "fast")
+		manager.ObserveRequest("slow", OutcomeTimeout, time.Minute, 0)
+		manager.ObserveRequest("fast", OutcomeSuccess, time.Millisecond*10, share.Size)
+
+		for i := 0; i < 3; i++ {
+			peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+			require.NoError(t, err)
+			done(ResultNoop)
+			require.Equal(t, peer.ID("fast"), peerID)
+		}
+
+		stopManager(t, manager)
+	})
+
 	t.Run("mark pool synced", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 		t.Cleanup(cancel)
@@ -393,6 +463,7 @@ func TestIntegration(t *testing.T) {
 		bnDisc := discovery.NewDiscovery(
 			nw.Hosts()[0],
 			routingdisc.NewRoutingDiscovery(router1),
+			discovery.FullNodesTag,
 			discovery.WithPeersLimit(0),
 			discovery.WithAdvertiseInterval(time.Second),
 		)
@@ -404,6 +475,7 @@ func TestIntegration(t *testing.T) {
 		fnDisc := discovery.NewDiscovery(
 			nw.Hosts()[1],
 			routingdisc.NewRoutingDiscovery(router2),
+			discovery.FullNodesTag,
 			discovery.WithPeersLimit(10),
 			discovery.WithAdvertiseInterval(time.Second),
 		)
@@ -423,7 +495,9 @@ func TestIntegration(t *testing.T) {
 			nil,
 			fnDisc,
 			nil,
+			nil,
 			connGater,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -460,6 +534,13 @@ func testManager(ctx context.Context, headerSub libhead.Subscriber[*header.Exten
 
 	disc := discovery.NewDiscovery(nil,
 		routingdisc.NewRoutingDiscovery(routinghelpers.Null{}),
+		discovery.FullNodesTag,
+		discovery.WithPeersLimit(0),
+		discovery.WithAdvertiseInterval(time.Second),
+	)
+	archivalDisc := discovery.NewDiscovery(nil,
+		routingdisc.NewRoutingDiscovery(routinghelpers.Null{}),
+		discovery.ArchivalNodesTag,
 		discovery.WithPeersLimit(0),
 		discovery.WithAdvertiseInterval(time.Second),
 	)
@@ -472,8 +553,10 @@ func testManager(ctx context.Context, headerSub libhead.Subscriber[*header.Exten
 		headerSub,
 		shrexSub,
 		disc,
+		archivalDisc,
 		host,
 		connGater,
+		nil,
 	)
 	if err != nil {
 		return nil, err
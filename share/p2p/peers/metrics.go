@@ -3,6 +3,7 @@ package peers
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
 	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
 	"go.opentelemetry.io/otel/metric/instrument/syncint64"
 
@@ -26,6 +28,8 @@ const (
 	sourceKey                  = "source"
 	sourceShrexSub  peerSource = "shrexsub"
 	sourceFullNodes peerSource = "full_nodes"
+	// sourceArchivalNodes is the source of peers discovered as archival full nodes
+	sourceArchivalNodes peerSource = "archival_nodes"
 
 	blacklistPeerReasonKey                     = "blacklist_reason"
 	reasonInvalidHash      blacklistPeerReason = "invalid_hash"
@@ -40,6 +44,13 @@ const (
 	peerStatusActive   peerStatus = "active"
 	peerStatusCooldown peerStatus = "cooldown"
 
+	requestOutcomeKey = "request_outcome"
+
+	scoreStatKey           = "score_stat"
+	scoreStatMin scoreStat = "min"
+	scoreStatAvg scoreStat = "avg"
+	scoreStatMax scoreStat = "max"
+
 	poolStatusKey                    = "pool_status"
 	poolStatusCreated     poolStatus = "created"
 	poolStatusValidated   poolStatus = "validated"
@@ -65,17 +76,22 @@ type poolStatus string
 
 type peerSource string
 
+type scoreStat string
+
 type metrics struct {
 	getPeer                  syncint64.Counter   // attributes: source, is_instant
 	getPeerWaitTimeHistogram syncint64.Histogram // attributes: source
 	getPeerPoolSizeHistogram syncint64.Histogram // attributes: source
 	doneResult               syncint64.Counter   // attributes: source, done_result
 	validationResult         syncint64.Counter   // attributes: validation_result
+	requestOutcome           syncint64.Counter   // attributes: request_outcome
+	requestLatencyHistogram  syncint64.Histogram // attributes: request_outcome
 
 	shrexPools               asyncint64.Gauge // attributes: pool_status
 	fullNodesPool            asyncint64.Gauge // attributes: pool_status
 	blacklistedPeersByReason sync.Map
-	blacklistedPeers         asyncint64.Gauge // attributes: blacklist_reason
+	blacklistedPeers         asyncint64.Gauge   // attributes: blacklist_reason
+	peerScores               asyncfloat64.Gauge // attributes: score_stat
 }
 
 func initMetrics(manager *Manager) (*metrics, error) {
@@ -109,6 +125,24 @@ func initMetrics(manager *Manager) (*metrics, error) {
 		return nil, err
 	}
 
+	requestOutcome, err := meter.SyncInt64().Counter("peer_manager_request_outcome_counter",
+		instrument.WithDescription("outcomes of requests made to peers"))
+	if err != nil {
+		return nil, err
+	}
+
+	requestLatencyHistogram, err := meter.SyncInt64().Histogram("peer_manager_request_ms_time_hist",
+		instrument.WithDescription("latency of requests made to peers(ms)"))
+	if err != nil {
+		return nil, err
+	}
+
+	peerScores, err := meter.AsyncFloat64().Gauge("peer_manager_peer_score_gauge",
+		instrument.WithDescription("min, avg and max score of the peers with observed requests"))
+	if err != nil {
+		return nil, err
+	}
+
 	shrexPools, err := meter.AsyncInt64().Gauge("peer_manager_pools_gauge",
 		instrument.WithDescription("pools amount"))
 	if err != nil {
@@ -132,6 +166,9 @@ func initMetrics(manager *Manager) (*metrics, error) {
 		getPeerWaitTimeHistogram: getPeerWaitTimeHistogram,
 		doneResult:               doneResult,
 		validationResult:         validationResult,
+		requestOutcome:           requestOutcome,
+		requestLatencyHistogram:  requestLatencyHistogram,
+		peerScores:               peerScores,
 		shrexPools:               shrexPools,
 		fullNodesPool:            fullNodesPool,
 		getPeerPoolSizeHistogram: getPeerPoolSizeHistogram,
@@ -143,6 +180,7 @@ func initMetrics(manager *Manager) (*metrics, error) {
 			shrexPools,
 			fullNodesPool,
 			blacklisted,
+			peerScores,
 		},
 		func(ctx context.Context) {
 			for poolStatus, count := range manager.shrexPools() {
@@ -162,6 +200,11 @@ func initMetrics(manager *Manager) (*metrics, error) {
 					attribute.String(blacklistPeerReasonKey, string(reason)))
 				return true
 			})
+
+			for stat, score := range manager.scoreStats() {
+				peerScores.Observe(ctx, score,
+					attribute.String(scoreStatKey, string(stat)))
+			}
 		},
 	)
 
@@ -204,6 +247,19 @@ func (m *metrics) observeDoneResult(source peerSource, result result) {
 		attribute.String(doneResultKey, string(result)))
 }
 
+func (m *metrics) observeRequest(outcome RequestOutcome, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), observeTimeout)
+	defer cancel()
+
+	m.requestOutcome.Add(ctx, 1,
+		attribute.String(requestOutcomeKey, string(outcome)))
+	m.requestLatencyHistogram.Record(ctx, latency.Milliseconds(),
+		attribute.String(requestOutcomeKey, string(outcome)))
+}
+
 // validationObserver is a middleware that observes validation results as metrics
 func (m *metrics) validationObserver(validator shrexsub.ValidatorFn) shrexsub.ValidatorFn {
 	if m == nil {
@@ -275,3 +331,26 @@ func (m *Manager) shrexPools() map[poolStatus]int64 {
 	shrexPools[poolStatusBlacklisted] = int64(len(m.blacklistedHashes))
 	return shrexPools
 }
+
+// scoreStats collects min, avg and max score of the peers with observed requests
+func (m *Manager) scoreStats() map[scoreStat]float64 {
+	if m.scores == nil {
+		return nil
+	}
+
+	scores := m.scores.scores()
+	if len(scores) == 0 {
+		return nil
+	}
+
+	stats := map[scoreStat]float64{
+		scoreStatMin: 1,
+		scoreStatMax: 0,
+	}
+	for _, score := range scores {
+		stats[scoreStatMin] = math.Min(stats[scoreStatMin], score)
+		stats[scoreStatMax] = math.Max(stats[scoreStatMax], score)
+		stats[scoreStatAvg] += score / float64(len(scores))
+	}
+	return stats
+}
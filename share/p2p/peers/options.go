@@ -19,6 +19,16 @@ type Parameters struct {
 
 	// EnableBlackListing turns on blacklisting for misbehaved peers
 	EnableBlackListing bool
+
+	// ArchivalWindow is the amount of the most recent heights full nodes are expected to keep
+	// before pruning them. Peers discovered as archival full nodes are preferred for older
+	// heights. Set 0 to disable archival peer discovery and preference.
+	ArchivalWindow uint64
+
+	// ScoreHalfLife is the time after which the weight of the requests observed to score a peer
+	// halves. Peers are scored by the latency, throughput, timeout and not found rates of the
+	// requests made to them, and better scored peers are preferred. Set 0 to disable peer scoring.
+	ScoreHalfLife time.Duration
 }
 
 // Validate validates the values in Parameters
@@ -35,6 +45,10 @@ func (p *Parameters) Validate() error {
 		return fmt.Errorf("peer-manager: garbage collection interval must be positive")
 	}
 
+	if p.ScoreHalfLife < 0 {
+		return fmt.Errorf("peer-manager: score half-life must not be negative")
+	}
+
 	return nil
 }
 
@@ -53,6 +67,9 @@ func DefaultParameters() Parameters {
 		// blacklisting is off by default //TODO(@walldiss): enable blacklisting once all related issues
 		// are resolved
 		EnableBlackListing: false,
+		// ScoreHalfLife's default value lets peers recover from a bad streak within hours, while still
+		// remembering them across restarts.
+		ScoreHalfLife: 30 * time.Minute,
 	}
 }
 
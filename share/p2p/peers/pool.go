@@ -10,7 +10,8 @@ import (
 
 const defaultCleanupThreshold = 2
 
-// pool stores peers and provides methods for simple round-robin access.
+// pool stores peers and provides methods for round-robin access, optionally preferring better
+// scored peers.
 type pool struct {
 	m           sync.RWMutex
 	peersList   []peer.ID
@@ -19,6 +20,9 @@ type pool struct {
 	activeCount int
 	nextIdx     int
 
+	// score is optional, without it peers are returned in the plain round-robin order
+	score func(peer.ID) float64
+
 	hasPeer   bool
 	hasPeerCh chan struct{}
 
@@ -45,7 +49,9 @@ func newPool(peerCooldownTime time.Duration) *pool {
 	return p
 }
 
-// tryGet returns peer along with bool flag indicating success of operation.
+// tryGet returns peer along with bool flag indicating success of operation. If the pool scores
+// peers, the better scored of the next two active peers is returned, so that better peers are
+// preferred, while the load is still spread across all of them.
 func (p *pool) tryGet() (peer.ID, bool) {
 	p.m.Lock()
 	defer p.m.Unlock()
@@ -59,24 +65,34 @@ func (p *pool) tryGet() (peer.ID, bool) {
 		p.nextIdx = 0
 	}
 
-	start := p.nextIdx
-	for {
-		peerID := p.peersList[p.nextIdx]
-
-		p.nextIdx++
-		if p.nextIdx == len(p.peersList) {
-			p.nextIdx = 0
+	idx, ok := p.nextActive(p.nextIdx)
+	if !ok {
+		return "", false
+	}
+	if p.score != nil && p.activeCount > 1 {
+		otherIdx, _ := p.nextActive(idx + 1)
+		if p.score(p.peersList[otherIdx]) > p.score(p.peersList[idx]) {
+			idx = otherIdx
 		}
+	}
 
-		if p.statuses[peerID] == active {
-			return peerID, true
-		}
+	p.nextIdx = idx + 1
+	if p.nextIdx == len(p.peersList) {
+		p.nextIdx = 0
+	}
+	return p.peersList[idx], true
+}
 
-		// full circle passed
-		if p.nextIdx == start {
-			return "", false
+// nextActive returns the index of the first active peer starting from the given index, wrapping
+// around the end of the list.
+func (p *pool) nextActive(from int) (int, bool) {
+	for i := 0; i < len(p.peersList); i++ {
+		idx := (from + i) % len(p.peersList)
+		if p.statuses[p.peersList[idx]] == active {
+			return idx, true
 		}
 	}
+	return 0, false
 }
 
 // next sends a peer to the returned channel when it becomes available.
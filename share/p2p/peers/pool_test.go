@@ -65,6 +65,28 @@ func TestPool(t *testing.T) {
 		require.Equal(t, peer.ID("peer1"), peerID)
 	})
 
+	t.Run("prefer better scored", func(t *testing.T) {
+		p := newPool(time.Second)
+		scores := map[peer.ID]float64{"peer1": 0.2, "peer2": 0.8, "peer3": 0.5}
+		p.score = func(peerID peer.ID) float64 {
+			return scores[peerID]
+		}
+		p.add("peer1", "peer2", "peer3")
+
+		// the better of the next two peers is returned and the pointer moves past it
+		for _, expected := range []peer.ID{"peer2", "peer3", "peer2", "peer3"} {
+			peerID, ok := p.tryGet()
+			require.True(t, ok)
+			require.Equal(t, expected, peerID)
+		}
+
+		// the only active peer is returned regardless of its score
+		p.remove("peer2", "peer3")
+		peerID, ok := p.tryGet()
+		require.True(t, ok)
+		require.Equal(t, peer.ID("peer1"), peerID)
+	})
+
 	t.Run("wait for peer", func(t *testing.T) {
 		timeout := time.Second
 		shortCtx, cancel := context.WithTimeout(context.Background(), timeout/10)
@@ -0,0 +1,252 @@
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// OutcomeSuccess indicates that the peer served the request.
+	OutcomeSuccess RequestOutcome = "success"
+	// OutcomeNotFound indicates that the peer did not have the requested data.
+	OutcomeNotFound RequestOutcome = "not_found"
+	// OutcomeTimeout indicates that the peer did not serve the request in time.
+	OutcomeTimeout RequestOutcome = "timeout"
+	// OutcomeFailure indicates that the request failed for any other reason.
+	OutcomeFailure RequestOutcome = "failure"
+)
+
+const (
+	// defaultScore is the score of the peers without any observed requests.
+	defaultScore = 0.5
+	// scoreAlpha is the weight of a single observation in the moving averages of peer statistics.
+	scoreAlpha = 0.2
+	// referenceLatency and referenceThroughput are the latency and throughput at which the speed
+	// of a peer is half of the best possible one.
+	referenceLatency    = time.Second
+	referenceThroughput = 1 << 20 // 1MiB/s
+	// minScoreWeight is the decayed amount of observations, below which the statistics of a peer are
+	// forgotten.
+	minScoreWeight = 0.01
+)
+
+var scoresPrefix = datastore.NewKey("peer_scores")
+
+// RequestOutcome is the outcome of a request made to a peer.
+type RequestOutcome string
+
+// peerStats are the statistics of the requests made to a single peer. All of them are moving
+// averages, except for Weight.
+type peerStats struct {
+	// Weight is the amount of observed requests decayed over time.
+	Weight float64 `json:"weight"`
+	// Latency is the request latency in seconds.
+	Latency float64 `json:"latency"`
+	// Throughput is the amount of bytes per second served by successful requests. Zero means there
+	// were no such requests.
+	Throughput   float64   `json:"throughput"`
+	TimeoutRate  float64   `json:"timeout_rate"`
+	NotFoundRate float64   `json:"not_found_rate"`
+	FailureRate  float64   `json:"failure_rate"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// decay reduces the weight of the observations made before the given time, halving it every
+// halfLife.
+func (s *peerStats) decay(now time.Time, halfLife time.Duration) {
+	if elapsed := now.Sub(s.UpdatedAt); elapsed > 0 {
+		s.Weight *= math.Exp2(-elapsed.Seconds() / halfLife.Seconds())
+	}
+	s.UpdatedAt = now
+}
+
+func (s *peerStats) observe(outcome RequestOutcome, latency time.Duration, size int) {
+	// the first observations define the statistics more than the later ones
+	alpha := math.Max(scoreAlpha, 1/(s.Weight+1))
+	ewma := func(avg, val float64) float64 {
+		return avg*(1-alpha) + val*alpha
+	}
+	indicator := func(o RequestOutcome) float64 {
+		if outcome == o {
+			return 1
+		}
+		return 0
+	}
+
+	s.Latency = ewma(s.Latency, latency.Seconds())
+	s.TimeoutRate = ewma(s.TimeoutRate, indicator(OutcomeTimeout))
+	s.NotFoundRate = ewma(s.NotFoundRate, indicator(OutcomeNotFound))
+	s.FailureRate = ewma(s.FailureRate, indicator(OutcomeFailure))
+	if outcome == OutcomeSuccess && size > 0 && latency > 0 {
+		throughput := float64(size) / latency.Seconds()
+		if s.Throughput == 0 {
+			s.Throughput = throughput
+		} else {
+			s.Throughput = ewma(s.Throughput, throughput)
+		}
+	}
+	s.Weight++
+}
+
+// score combines the statistics into a value within [0, 1]. The less observations back the
+// statistics, the closer the score is to the defaultScore.
+func (s *peerStats) score() float64 {
+	// not found is partially excused, as the peer may just not have synced the data yet
+	reliability := (1 - s.TimeoutRate) * (1 - s.FailureRate) * (1 - s.NotFoundRate/2)
+
+	latency := time.Duration(s.Latency * float64(time.Second))
+	speed := float64(referenceLatency) / float64(referenceLatency+latency)
+	if s.Throughput > 0 {
+		speed = (speed + s.Throughput/(s.Throughput+referenceThroughput)) / 2
+	}
+
+	confidence := s.Weight / (s.Weight + 1)
+	return defaultScore + (reliability*speed-defaultScore)*confidence
+}
+
+// peerScores keeps track of the peer statistics, decaying them over time and persisting them in the
+// datastore.
+type peerScores struct {
+	lock     sync.Mutex
+	halfLife time.Duration
+	stats    map[peer.ID]*peerStats
+
+	// ds is optional, without it the statistics are not persisted
+	ds datastore.Batching
+}
+
+func newPeerScores(halfLife time.Duration, ds datastore.Batching) *peerScores {
+	s := &peerScores{
+		halfLife: halfLife,
+		stats:    make(map[peer.ID]*peerStats),
+	}
+	if ds != nil {
+		s.ds = namespace.Wrap(ds, scoresPrefix)
+	}
+	return s
+}
+
+// observe updates the statistics of the peer with the outcome of a request.
+func (s *peerScores) observe(peerID peer.ID, outcome RequestOutcome, latency time.Duration, size int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats, ok := s.stats[peerID]
+	if !ok {
+		stats = &peerStats{}
+		s.stats[peerID] = stats
+	}
+	stats.decay(time.Now(), s.halfLife)
+	stats.observe(outcome, latency, size)
+}
+
+// score returns the current score of the peer.
+func (s *peerScores) score(peerID peer.ID) float64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stats, ok := s.stats[peerID]
+	if !ok {
+		return defaultScore
+	}
+	stats.decay(time.Now(), s.halfLife)
+	return stats.score()
+}
+
+// scores returns the current scores of all the peers with observed requests.
+func (s *peerScores) scores() map[peer.ID]float64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	scores := make(map[peer.ID]float64, len(s.stats))
+	for peerID, stats := range s.stats {
+		stats.decay(now, s.halfLife)
+		scores[peerID] = stats.score()
+	}
+	return scores
+}
+
+// load restores the persisted statistics.
+func (s *peerScores) load(ctx context.Context) error {
+	if s.ds == nil {
+		return nil
+	}
+
+	res, err := s.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return fmt.Errorf("querying peer scores: %w", err)
+	}
+	defer res.Close()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for entry := range res.Next() {
+		if entry.Error != nil {
+			return fmt.Errorf("reading peer scores: %w", entry.Error)
+		}
+
+		peerID, err := peer.Decode(datastore.RawKey(entry.Key).BaseNamespace())
+		if err != nil {
+			log.Warnw("skipping peer score with invalid key", "key", entry.Key, "err", err)
+			continue
+		}
+		stats := &peerStats{}
+		if err := json.Unmarshal(entry.Value, stats); err != nil {
+			log.Warnw("skipping invalid peer score", "peer", peerID, "err", err)
+			continue
+		}
+		s.stats[peerID] = stats
+	}
+	return nil
+}
+
+// save persists the statistics, forgetting the ones that decayed below minScoreWeight.
+func (s *peerScores) save(ctx context.Context) error {
+	if s.ds == nil {
+		return nil
+	}
+
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("creating batch: %w", err)
+	}
+
+	s.lock.Lock()
+	now := time.Now()
+	for peerID, stats := range s.stats {
+		key := datastore.NewKey(peerID.String())
+		stats.decay(now, s.halfLife)
+		if stats.Weight < minScoreWeight {
+			delete(s.stats, peerID)
+			err = errors.Join(err, batch.Delete(ctx, key))
+			continue
+		}
+
+		bs, marshalErr := json.Marshal(stats)
+		if marshalErr != nil {
+			err = errors.Join(err, marshalErr)
+			continue
+		}
+		err = errors.Join(err, batch.Put(ctx, key, bs))
+	}
+	s.lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("storing peer scores: %w", err)
+	}
+
+	if err := batch.Commit(ctx); err != nil {
+		return fmt.Errorf("committing peer scores: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package peers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerStats(t *testing.T) {
+	var fast, slow, timingOut, notFound peerStats
+	for i := 0; i < 10; i++ {
+		fast.observe(OutcomeSuccess, time.Millisecond*100, 1<<20)
+		slow.observe(OutcomeSuccess, time.Second*5, 1<<20)
+		timingOut.observe(OutcomeTimeout, time.Millisecond*100, 0)
+		notFound.observe(OutcomeNotFound, time.Millisecond*100, 0)
+	}
+	require.Greater(t, fast.score(), defaultScore)
+	require.Greater(t, fast.score(), slow.score())
+	require.Greater(t, notFound.score(), timingOut.score())
+	require.Less(t, timingOut.score(), defaultScore)
+
+	// the score returns to the default one as the observations decay
+	now := time.Now()
+	fast.UpdatedAt, timingOut.UpdatedAt = now, now
+	fast.decay(now.Add(time.Hour*10), time.Hour)
+	timingOut.decay(now.Add(time.Hour*10), time.Hour)
+	require.InDelta(t, defaultScore, fast.score(), 0.01)
+	require.InDelta(t, defaultScore, timingOut.score(), 0.01)
+}
+
+func TestPeerScores_Persistence(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	good, bad, forgotten := test.RandPeerIDFatal(t), test.RandPeerIDFatal(t), test.RandPeerIDFatal(t)
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	scores := newPeerScores(time.Hour, ds)
+	scores.observe(good, OutcomeSuccess, time.Millisecond*100, 1<<20)
+	scores.observe(bad, OutcomeFailure, time.Second, 0)
+	scores.observe(forgotten, OutcomeFailure, time.Second, 0)
+	// make the observations of a peer decay below the minimal weight
+	scores.stats[forgotten].UpdatedAt = time.Now().Add(-time.Hour * 10)
+	require.NoError(t, scores.save(ctx))
+
+	restored := newPeerScores(time.Hour, ds)
+	require.NoError(t, restored.load(ctx))
+	require.Len(t, restored.stats, 2)
+	for _, peerID := range []peer.ID{good, bad} {
+		require.InDelta(t, scores.score(peerID), restored.score(peerID), 0.001)
+	}
+	require.Greater(t, restored.score(good), restored.score(bad))
+	require.Equal(t, defaultScore, restored.score(forgotten))
+}
@@ -26,8 +26,9 @@ import (
 // Client implements client side of shrex/nd protocol to obtain namespaced shares data from remote
 // peers.
 type Client struct {
-	params     *Parameters
-	protocolID protocol.ID
+	params          *Parameters
+	protocolID      protocol.ID
+	batchProtocolID protocol.ID
 
 	host    host.Host
 	metrics *p2p.Metrics
@@ -40,9 +41,10 @@ func NewClient(params *Parameters, host host.Host) (*Client, error) {
 	}
 
 	return &Client{
-		host:       host,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		params:     params,
+		host:            host,
+		protocolID:      p2p.ProtocolID(params.NetworkID(), protocolString),
+		batchProtocolID: p2p.ProtocolID(params.NetworkID(), batchProtocolString),
+		params:          params,
 	}, nil
 }
 
@@ -54,27 +56,72 @@ func (c *Client) RequestND(
 	nID namespace.ID,
 	peer peer.ID,
 ) (share.NamespacedShares, error) {
-	shares, err := c.doRequest(ctx, root, nID, peer)
+	it, err := c.RequestNDStream(ctx, root, nID, peer)
+	if err != nil {
+		return nil, err
+	}
+	return share.CollectNamespacedShares(ctx, it)
+}
+
+// RequestNDStream requests namespaced data from the given peer and returns the iterator over the
+// rows streamed by the peer. Every row is verified against the share.Root as it is read.
+func (c *Client) RequestNDStream(
+	ctx context.Context,
+	root *share.Root,
+	nID namespace.ID,
+	peer peer.ID,
+) (share.NamespacedRowIterator, error) {
+	it, err := c.doRequest(ctx, root, nID, peer)
 	if err == nil {
-		return shares, err
+		return it, nil
 	}
+	return nil, c.handleErr(ctx, err)
+}
+
+// RequestNDBatch requests namespaced data of multiple namespaces from the given peer at once.
+// Returns valid data with its verified inclusion against the share.Root. The namespaces not found
+// by the peer are omitted from the result.
+func (c *Client) RequestNDBatch(
+	ctx context.Context,
+	root *share.Root,
+	nIDs []namespace.ID,
+	peer peer.ID,
+) (share.NamespacedSharesMap, error) {
+	shares, err := c.doBatchRequest(ctx, root, nIDs, peer)
+	if err == nil {
+		return shares, nil
+	}
+	return nil, c.handleErr(ctx, err)
+}
+
+// handleErr records the metrics of the failed request and converts the timeouts into
+// context.DeadlineExceeded.
+func (c *Client) handleErr(ctx context.Context, err error) error {
 	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 		c.metrics.ObserveRequests(1, p2p.StatusTimeout)
-		return nil, err
+		return err
 	}
+	if isDeadlineExceeded(ctx, err) {
+		c.metrics.ObserveRequests(1, p2p.StatusTimeout)
+		return context.DeadlineExceeded
+	}
+	if err != p2p.ErrNotFound {
+		log.Warnw("client-nd: peer returned err", "err", err)
+	}
+	return err
+}
+
+// isDeadlineExceeded reports whether the error is a net.Error timeout caused by the exceeded
+// context deadline.
+func isDeadlineExceeded(ctx context.Context, err error) bool {
 	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
 	// unwrap to a ctx err
 	var ne net.Error
 	if errors.As(err, &ne) && ne.Timeout() {
-		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
-			c.metrics.ObserveRequests(1, p2p.StatusTimeout)
-			return nil, context.DeadlineExceeded
-		}
-	}
-	if err != p2p.ErrNotFound {
-		log.Warnw("client-nd: peer returned err", "err", err)
+		deadline, _ := ctx.Deadline()
+		return deadline.Before(time.Now())
 	}
-	return nil, err
+	return false
 }
 
 func (c *Client) doRequest(
@@ -82,12 +129,11 @@ func (c *Client) doRequest(
 	root *share.Root,
 	nID namespace.ID,
 	peerID peer.ID,
-) (share.NamespacedShares, error) {
+) (*rowIterator, error) {
 	stream, err := c.host.NewStream(ctx, peerID, c.protocolID)
 	if err != nil {
 		return nil, err
 	}
-	defer stream.Close()
 
 	c.setStreamDeadlines(ctx, stream)
 
@@ -107,56 +153,233 @@ func (c *Client) doRequest(
 		log.Debugw("client-nd: closing write side of the stream", "err", err)
 	}
 
-	var resp pb.GetSharesByNamespaceResponse
+	var resp pb.GetSharesByNamespaceStatusResponse
 	_, err = serde.Read(stream, &resp)
 	if err != nil {
+		stream.Reset() //nolint:errcheck
 		// server is overloaded and closed the stream
 		if errors.Is(err, io.EOF) {
 			c.metrics.ObserveRequests(1, p2p.StatusRateLimited)
 			return nil, p2p.ErrNotFound
 		}
-		stream.Reset() //nolint:errcheck
-		return nil, fmt.Errorf("client-nd: reading response: %w", err)
+		return nil, fmt.Errorf("client-nd: reading status response: %w", err)
 	}
 
 	if err = c.statusToErr(resp.Status); err != nil {
+		stream.Close() //nolint:errcheck
 		return nil, fmt.Errorf("client-nd: response code is not OK: %w", err)
 	}
 
-	shares, err := convertToNamespacedShares(resp.Rows)
+	return &rowIterator{
+		client:   c,
+		stream:   stream,
+		nID:      nID,
+		rowRoots: share.RowRootsByNamespace(root, nID),
+	}, nil
+}
+
+func (c *Client) doBatchRequest(
+	ctx context.Context,
+	root *share.Root,
+	nIDs []namespace.ID,
+	peerID peer.ID,
+) (share.NamespacedSharesMap, error) {
+	if len(nIDs) == 0 || len(nIDs) > MaxNamespacesPerRequest {
+		return nil, fmt.Errorf("client-nd: amount of namespace IDs must be within [1, %d], got %d",
+			MaxNamespacesPerRequest, len(nIDs))
+	}
+
+	stream, err := c.host.NewStream(ctx, peerID, c.batchProtocolID)
 	if err != nil {
-		return nil, fmt.Errorf("client-nd: converting response to shares: %w", err)
+		return nil, err
+	}
+	defer stream.Close()
+
+	c.setStreamDeadlines(ctx, stream)
+
+	req := &pb.GetSharesByNamespacesRequest{
+		RootHash:     root.Hash(),
+		NamespaceIds: make([][]byte, len(nIDs)),
+	}
+	for i, nID := range nIDs {
+		req.NamespaceIds[i] = nID
 	}
 
-	err = shares.Verify(root, nID)
+	_, err = serde.Write(stream, req)
 	if err != nil {
-		return nil, fmt.Errorf("client-nd: verifying response: %w", err)
+		stream.Reset() //nolint:errcheck
+		return nil, fmt.Errorf("client-nd: writing request: %w", err)
+	}
+
+	err = stream.CloseWrite()
+	if err != nil {
+		log.Debugw("client-nd: closing write side of the stream", "err", err)
+	}
+
+	var resp pb.GetSharesByNamespaceStatusResponse
+	_, err = serde.Read(stream, &resp)
+	if err != nil {
+		// server is overloaded and closed the stream
+		if errors.Is(err, io.EOF) {
+			c.metrics.ObserveRequests(1, p2p.StatusRateLimited)
+			return nil, p2p.ErrNotFound
+		}
+		stream.Reset() //nolint:errcheck
+		return nil, fmt.Errorf("client-nd: reading status response: %w", err)
 	}
 
+	if err = c.statusToErr(resp.Status); err != nil {
+		return nil, fmt.Errorf("client-nd: response code is not OK: %w", err)
+	}
+
+	shares := make(share.NamespacedSharesMap, len(nIDs))
+	for _, nID := range nIDs {
+		rows, err := c.readNamespace(ctx, stream, root, nID)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return nil, err
+		}
+		if rows != nil {
+			shares[nID.String()] = rows
+		}
+	}
+
+	if len(shares) == 0 {
+		return nil, p2p.ErrNotFound
+	}
 	return shares, nil
 }
 
-// convertToNamespacedShares converts proto Rows to share.NamespacedShares
-func convertToNamespacedShares(rows []*pb.Row) (share.NamespacedShares, error) {
-	shares := make([]share.NamespacedRow, 0, len(rows))
-	for _, row := range rows {
-		var proof *nmt.Proof
-		if row.Proof != nil {
-			tmpProof := nmt.NewInclusionProof(
+// readNamespace reads the status of a single namespace within the batch response followed by all
+// its rows. It returns nil shares if the namespace was not found.
+func (c *Client) readNamespace(
+	ctx context.Context,
+	stream network.Stream,
+	root *share.Root,
+	nID namespace.ID,
+) (share.NamespacedShares, error) {
+	c.setStreamReadDeadline(ctx, stream)
+
+	var resp pb.GetSharesByNamespaceStatusResponse
+	_, err := serde.Read(stream, &resp)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// server closed the stream before sending all the namespaces
+			err = p2p.ErrInvalidResponse
+		}
+		return nil, fmt.Errorf("client-nd: reading status of nID %s: %w", nID.String(), err)
+	}
+
+	switch resp.Status {
+	case pb.StatusCode_OK:
+	case pb.StatusCode_NOT_FOUND:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("client-nd: unexpected status of nID %s: %w", nID.String(), p2p.ErrInvalidResponse)
+	}
+
+	it := &rowIterator{
+		client:   c,
+		stream:   stream,
+		nID:      nID,
+		rowRoots: share.RowRootsByNamespace(root, nID),
+	}
+	shares := make(share.NamespacedShares, 0, len(it.rowRoots))
+	for {
+		row, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) && len(shares) == 0 {
+			return nil, nil
+		}
+		if errors.Is(err, io.EOF) {
+			return shares, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, row)
+	}
+}
+
+// rowIterator implements share.NamespacedRowIterator reading the rows streamed by the server one
+// message at a time and verifying each of them against the corresponding row root.
+type rowIterator struct {
+	client *Client
+	stream network.Stream
+
+	nID      namespace.ID
+	rowRoots [][]byte
+	next     int
+}
+
+func (it *rowIterator) Next(ctx context.Context) (share.NamespacedRow, error) {
+	if it.next == len(it.rowRoots) {
+		return share.NamespacedRow{}, io.EOF
+	}
+
+	// extend the deadline for every row, so that large namespaces are not limited by the time
+	// given to a single message
+	it.client.setStreamReadDeadline(ctx, it.stream)
+
+	var resp pb.NamespaceRowResponse
+	_, err := serde.Read(it.stream, &resp)
+	if err != nil {
+		it.stream.Reset() //nolint:errcheck
+		switch {
+		case errors.Is(err, io.EOF):
+			// server closed the stream before sending all the rows
+			err = p2p.ErrInvalidResponse
+		case isDeadlineExceeded(ctx, err):
+			err = context.DeadlineExceeded
+		}
+		return share.NamespacedRow{}, fmt.Errorf("client-nd: reading row %d: %w", it.next, err)
+	}
+
+	row := convertToNamespacedRow(&resp)
+	if !row.Verify(it.rowRoots[it.next], it.nID) {
+		it.stream.Reset() //nolint:errcheck
+		return share.NamespacedRow{}, fmt.Errorf("client-nd: verifying row %d: %w", it.next, p2p.ErrInvalidResponse)
+	}
+
+	it.next++
+	return row, nil
+}
+
+func (it *rowIterator) Close() error {
+	if it.next < len(it.rowRoots) {
+		// no need to read the rest of the rows
+		return it.stream.Reset()
+	}
+	return it.stream.Close()
+}
+
+// convertToNamespacedRow converts proto NamespaceRowResponse to share.NamespacedRow
+func convertToNamespacedRow(row *pb.NamespaceRowResponse) share.NamespacedRow {
+	var proof *nmt.Proof
+	if row.Proof != nil {
+		var tmpProof nmt.Proof
+		if len(row.Proof.LeafHash) != 0 {
+			tmpProof = nmt.NewAbsenceProof(
+				int(row.Proof.Start),
+				int(row.Proof.End),
+				row.Proof.Nodes,
+				row.Proof.LeafHash,
+				ipld.NMTIgnoreMaxNamespace,
+			)
+		} else {
+			tmpProof = nmt.NewInclusionProof(
 				int(row.Proof.Start),
 				int(row.Proof.End),
 				row.Proof.Nodes,
 				ipld.NMTIgnoreMaxNamespace,
 			)
-			proof = &tmpProof
 		}
+		proof = &tmpProof
+	}
 
-		shares = append(shares, share.NamespacedRow{
-			Shares: row.Shares,
-			Proof:  proof,
-		})
+	return share.NamespacedRow{
+		Shares: row.Shares,
+		Proof:  proof,
 	}
-	return shares, nil
 }
 
 func (c *Client) setStreamDeadlines(ctx context.Context, stream network.Stream) {
@@ -187,6 +410,23 @@ func (c *Client) setStreamDeadlines(ctx context.Context, stream network.Stream)
 	}
 }
 
+// setStreamReadDeadline sets the read deadline of the stream to the context deadline if it exists
+// or to the server write timeout otherwise.
+func (c *Client) setStreamReadDeadline(ctx context.Context, stream network.Stream) {
+	deadline, ok := ctx.Deadline()
+	if !ok && c.params.ServerWriteTimeout != 0 {
+		deadline, ok = time.Now().Add(c.params.ServerWriteTimeout), true
+	}
+	if !ok {
+		return
+	}
+
+	err := stream.SetReadDeadline(deadline)
+	if err != nil {
+		log.Debugw("client-nd: set read deadline", "err", err)
+	}
+}
+
 func (c *Client) statusToErr(code pb.StatusCode) error {
 	switch code {
 	case pb.StatusCode_OK:
@@ -121,6 +121,30 @@ func (m notFoundGetter) GetSharesByNamespace(
 	return nil, share.ErrNotFound
 }
 
+func (m notFoundGetter) GetSharesByNamespaces(
+	_ context.Context, _ *share.Root, _ []namespace.ID,
+) (share.NamespacedSharesMap, error) {
+	return nil, share.ErrNotFound
+}
+
+func (m notFoundGetter) GetSharesByNamespaceStream(
+	_ context.Context, _ *share.Root, _ namespace.ID,
+) (share.NamespacedRowIterator, error) {
+	return nil, share.ErrNotFound
+}
+
+func (m notFoundGetter) GetRow(
+	_ context.Context, _ *share.Root, _ int, _ rsmt2d.Axis,
+) (*share.AxisShares, error) {
+	return nil, share.ErrNotFound
+}
+
+func (m notFoundGetter) GetRange(
+	_ context.Context, _ *share.Root, _, _ int,
+) (share.RangeShares, error) {
+	return nil, share.ErrNotFound
+}
+
 func newStore(t *testing.T) *eds.Store {
 	t.Helper()
 
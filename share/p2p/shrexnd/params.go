@@ -8,7 +8,13 @@ import (
 	"github.com/celestiaorg/celestia-node/share/p2p"
 )
 
-const protocolString = "/shrex/nd/0.0.1"
+const (
+	protocolString      = "/shrex/nd/0.0.3"
+	batchProtocolString = "/shrex/nd/batch/0.0.2"
+)
+
+// MaxNamespacesPerRequest limits the amount of namespace IDs that can be requested at once.
+const MaxNamespacesPerRequest = 64
 
 var log = logging.Logger("shrex/nd")
 
@@ -105,23 +105,24 @@ func (m *GetSharesByNamespaceRequest) GetNamespaceId() []byte {
 	return nil
 }
 
-type GetSharesByNamespaceResponse struct {
-	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.nd.StatusCode" json:"status,omitempty"`
-	Rows   []*Row     `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+// GetSharesByNamespacesRequest requests the shares of multiple namespaces at once.
+type GetSharesByNamespacesRequest struct {
+	RootHash     []byte   `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	NamespaceIds [][]byte `protobuf:"bytes,2,rep,name=namespace_ids,json=namespaceIds,proto3" json:"namespace_ids,omitempty"`
 }
 
-func (m *GetSharesByNamespaceResponse) Reset()         { *m = GetSharesByNamespaceResponse{} }
-func (m *GetSharesByNamespaceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSharesByNamespaceResponse) ProtoMessage()    {}
-func (*GetSharesByNamespaceResponse) Descriptor() ([]byte, []int) {
+func (m *GetSharesByNamespacesRequest) Reset()         { *m = GetSharesByNamespacesRequest{} }
+func (m *GetSharesByNamespacesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSharesByNamespacesRequest) ProtoMessage()    {}
+func (*GetSharesByNamespacesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ed9f13149b0de397, []int{1}
 }
-func (m *GetSharesByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetSharesByNamespacesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSharesByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetSharesByNamespacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSharesByNamespaceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetSharesByNamespacesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -131,49 +132,100 @@ func (m *GetSharesByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetSharesByNamespaceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSharesByNamespaceResponse.Merge(m, src)
+func (m *GetSharesByNamespacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharesByNamespacesRequest.Merge(m, src)
 }
-func (m *GetSharesByNamespaceResponse) XXX_Size() int {
+func (m *GetSharesByNamespacesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSharesByNamespaceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSharesByNamespaceResponse.DiscardUnknown(m)
+func (m *GetSharesByNamespacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharesByNamespacesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSharesByNamespaceResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetSharesByNamespacesRequest proto.InternalMessageInfo
 
-func (m *GetSharesByNamespaceResponse) GetStatus() StatusCode {
+func (m *GetSharesByNamespacesRequest) GetRootHash() []byte {
 	if m != nil {
-		return m.Status
+		return m.RootHash
 	}
-	return StatusCode_INVALID
+	return nil
 }
 
-func (m *GetSharesByNamespaceResponse) GetRows() []*Row {
+func (m *GetSharesByNamespacesRequest) GetNamespaceIds() [][]byte {
 	if m != nil {
-		return m.Rows
+		return m.NamespaceIds
 	}
 	return nil
 }
 
-type Row struct {
+// GetSharesByNamespaceStatusResponse is the first message sent in response to
+// GetSharesByNamespaceRequest. With the OK status, it is followed by a NamespaceRowResponse for
+// every row containing the namespace.
+//
+// In response to GetSharesByNamespacesRequest, the OK status is followed by the same sequence of
+// messages for every requested namespace in the order of the request, where the NOT_FOUND status
+// of a namespace is not followed by any rows.
+type GetSharesByNamespaceStatusResponse struct {
+	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.nd.StatusCode" json:"status,omitempty"`
+}
+
+func (m *GetSharesByNamespaceStatusResponse) Reset()         { *m = GetSharesByNamespaceStatusResponse{} }
+func (m *GetSharesByNamespaceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSharesByNamespaceStatusResponse) ProtoMessage()    {}
+func (*GetSharesByNamespaceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9f13149b0de397, []int{2}
+}
+func (m *GetSharesByNamespaceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSharesByNamespaceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharesByNamespaceStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSharesByNamespaceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharesByNamespaceStatusResponse.Merge(m, src)
+}
+func (m *GetSharesByNamespaceStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSharesByNamespaceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharesByNamespaceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSharesByNamespaceStatusResponse proto.InternalMessageInfo
+
+func (m *GetSharesByNamespaceStatusResponse) GetStatus() StatusCode {
+	if m != nil {
+		return m.Status
+	}
+	return StatusCode_INVALID
+}
+
+type NamespaceRowResponse struct {
 	Shares [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 	Proof  *Proof   `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *Row) Reset()         { *m = Row{} }
-func (m *Row) String() string { return proto.CompactTextString(m) }
-func (*Row) ProtoMessage()    {}
-func (*Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed9f13149b0de397, []int{2}
+func (m *NamespaceRowResponse) Reset()         { *m = NamespaceRowResponse{} }
+func (m *NamespaceRowResponse) String() string { return proto.CompactTextString(m) }
+func (*NamespaceRowResponse) ProtoMessage()    {}
+func (*NamespaceRowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed9f13149b0de397, []int{3}
 }
-func (m *Row) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceRowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceRowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Row.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NamespaceRowResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -183,43 +235,46 @@ func (m *Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Row) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Row.Merge(m, src)
+func (m *NamespaceRowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRowResponse.Merge(m, src)
 }
-func (m *Row) XXX_Size() int {
+func (m *NamespaceRowResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Row) XXX_DiscardUnknown() {
-	xxx_messageInfo_Row.DiscardUnknown(m)
+func (m *NamespaceRowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRowResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Row proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceRowResponse proto.InternalMessageInfo
 
-func (m *Row) GetShares() [][]byte {
+func (m *NamespaceRowResponse) GetShares() [][]byte {
 	if m != nil {
 		return m.Shares
 	}
 	return nil
 }
 
-func (m *Row) GetProof() *Proof {
+func (m *NamespaceRowResponse) GetProof() *Proof {
 	if m != nil {
 		return m.Proof
 	}
 	return nil
 }
 
+// Proof is the nmt proof of the row shares. A non-empty leaf_hash makes it the proof of the
+// namespace absence in the row.
 type Proof struct {
-	Start int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End   int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Nodes [][]byte `protobuf:"bytes,3,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Start    int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Nodes    [][]byte `protobuf:"bytes,3,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	LeafHash []byte   `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
 }
 
 func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed9f13149b0de397, []int{3}
+	return fileDescriptor_ed9f13149b0de397, []int{4}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,42 +324,51 @@ func (m *Proof) GetNodes() [][]byte {
 	return nil
 }
 
+func (m *Proof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("share.p2p.shrex.nd.StatusCode", StatusCode_name, StatusCode_value)
 	proto.RegisterType((*GetSharesByNamespaceRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceRequest")
-	proto.RegisterType((*GetSharesByNamespaceResponse)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceResponse")
-	proto.RegisterType((*Row)(nil), "share.p2p.shrex.nd.Row")
+	proto.RegisterType((*GetSharesByNamespacesRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespacesRequest")
+	proto.RegisterType((*GetSharesByNamespaceStatusResponse)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceStatusResponse")
+	proto.RegisterType((*NamespaceRowResponse)(nil), "share.p2p.shrex.nd.NamespaceRowResponse")
 	proto.RegisterType((*Proof)(nil), "share.p2p.shrex.nd.Proof")
 }
 
 func init() { proto.RegisterFile("share/p2p/shrexnd/pb/share.proto", fileDescriptor_ed9f13149b0de397) }
 
 var fileDescriptor_ed9f13149b0de397 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x86, 0x25, 0x6d, 0xad, 0xda, 0x23, 0xb5, 0x88, 0xa5, 0xb4, 0x2a, 0x2e, 0xc2, 0xd5, 0xc9,
-	0xb4, 0x20, 0x81, 0x0a, 0x3d, 0x16, 0xec, 0xda, 0x6d, 0x45, 0xcd, 0xba, 0xac, 0xdd, 0xdc, 0x82,
-	0x91, 0xa3, 0x0d, 0xca, 0x21, 0xda, 0x8d, 0x76, 0x8d, 0x93, 0x73, 0x5e, 0x20, 0x8f, 0x95, 0xa3,
-	0x8f, 0x39, 0x06, 0xfb, 0x45, 0x82, 0x56, 0x4e, 0x72, 0x88, 0x6f, 0xfa, 0xff, 0xf9, 0xe6, 0x9f,
-	0x19, 0x2d, 0xf4, 0x64, 0x91, 0x55, 0x2c, 0x16, 0x89, 0x88, 0x65, 0x51, 0xb1, 0xcb, 0x32, 0x8f,
-	0xc5, 0x32, 0xd6, 0x66, 0x24, 0x2a, 0xae, 0x38, 0xc6, 0x7b, 0x91, 0x88, 0x48, 0x13, 0x51, 0x99,
-	0x87, 0xc7, 0xd0, 0xfd, 0xcd, 0xd4, 0xac, 0x2e, 0xc8, 0xe1, 0x15, 0xc9, 0xce, 0x99, 0x14, 0xd9,
-	0x09, 0xa3, 0xec, 0x62, 0xc5, 0xa4, 0xc2, 0x5d, 0xe8, 0x54, 0x9c, 0xab, 0x45, 0x91, 0xc9, 0xc2,
-	0x37, 0x7b, 0x66, 0xdf, 0xa5, 0xed, 0xda, 0xf8, 0x93, 0xc9, 0x02, 0x7f, 0x06, 0xb7, 0x7c, 0x6c,
-	0x58, 0x9c, 0xe5, 0xbe, 0xa5, 0xeb, 0xce, 0x93, 0x97, 0xe6, 0xe1, 0xb5, 0x09, 0x9f, 0x0e, 0xe7,
-	0x4b, 0xc1, 0x4b, 0xc9, 0xf0, 0x77, 0xb0, 0xa5, 0xca, 0xd4, 0x4a, 0xea, 0xf4, 0xb7, 0x49, 0x10,
-	0xbd, 0x5c, 0x32, 0x9a, 0x69, 0xe2, 0x27, 0xcf, 0x19, 0xdd, 0xd3, 0xf8, 0x2b, 0xbc, 0xaa, 0xf8,
-	0x5a, 0xfa, 0x56, 0x0f, 0xf5, 0x9d, 0xe4, 0xc3, 0xa1, 0x2e, 0xca, 0xd7, 0x54, 0x43, 0x21, 0x01,
-	0x44, 0xf9, 0x1a, 0xbf, 0x07, 0x5b, 0x63, 0xf5, 0x2c, 0xd4, 0x77, 0xe9, 0x5e, 0xe1, 0x18, 0x5a,
-	0xa2, 0xe2, 0xfc, 0x54, 0x1f, 0xe0, 0x24, 0x1f, 0x0f, 0x85, 0xfd, 0xab, 0x01, 0xda, 0x70, 0xe1,
-	0x18, 0x5a, 0x5a, 0xe3, 0x77, 0xd0, 0x92, 0x2a, 0xab, 0x94, 0x5e, 0x1e, 0xd1, 0x46, 0x60, 0x0f,
-	0x10, 0x2b, 0x9b, 0xdf, 0x81, 0x68, 0xfd, 0x59, 0x73, 0x84, 0xe7, 0x4c, 0xfa, 0x48, 0x0f, 0x6e,
-	0xc4, 0x97, 0x1f, 0x00, 0xcf, 0x97, 0x61, 0x07, 0x5e, 0xa7, 0xe4, 0x68, 0x30, 0x49, 0x47, 0x9e,
-	0x81, 0x6d, 0xb0, 0xa6, 0x7f, 0x3d, 0x13, 0xbf, 0x81, 0x0e, 0x99, 0xce, 0x17, 0xbf, 0xa6, 0xff,
-	0xc9, 0xc8, 0xb3, 0xb0, 0x0b, 0xed, 0x94, 0xcc, 0xc7, 0x94, 0x0c, 0x26, 0x1e, 0x1a, 0xfa, 0xb7,
-	0xdb, 0xc0, 0xdc, 0x6c, 0x03, 0xf3, 0x7e, 0x1b, 0x98, 0x37, 0xbb, 0xc0, 0xd8, 0xec, 0x02, 0xe3,
-	0x6e, 0x17, 0x18, 0x4b, 0x5b, 0x3f, 0xf8, 0xb7, 0x87, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x23,
-	0xba, 0xf9, 0x14, 0x02, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
+	0x14, 0xcd, 0x87, 0x8d, 0xef, 0xdd, 0xe6, 0x49, 0x18, 0x1e, 0x12, 0x79, 0x12, 0x6a, 0xdc, 0x3c,
+	0x5c, 0x24, 0x10, 0xc1, 0xa5, 0xd0, 0x5a, 0x3f, 0x82, 0x25, 0x95, 0x69, 0x75, 0xa5, 0xc4, 0xa9,
+	0x99, 0x12, 0x41, 0x33, 0x63, 0xee, 0x14, 0xf5, 0x5f, 0xf8, 0xb3, 0x5c, 0x76, 0xe9, 0x52, 0xda,
+	0x3f, 0x22, 0x33, 0xa9, 0x6d, 0xc1, 0x2e, 0xdc, 0xe5, 0x9c, 0x7b, 0xee, 0x39, 0x37, 0x87, 0x81,
+	0x01, 0xd6, 0xac, 0xe5, 0xa9, 0xcc, 0x64, 0x8a, 0x75, 0xcb, 0xbf, 0x35, 0x55, 0x2a, 0x17, 0xa9,
+	0x21, 0x13, 0xd9, 0x0a, 0x25, 0x08, 0xd9, 0x81, 0x4c, 0x26, 0x46, 0x91, 0x34, 0x55, 0xfc, 0x0e,
+	0xae, 0x9e, 0x73, 0x35, 0xd3, 0x03, 0x1c, 0x7d, 0x2f, 0xd8, 0x67, 0x8e, 0x92, 0x7d, 0xe0, 0x94,
+	0x7f, 0x59, 0x71, 0x54, 0xe4, 0x0a, 0xce, 0x5b, 0x21, 0x54, 0x59, 0x33, 0xac, 0x43, 0x7b, 0x60,
+	0x5f, 0xfb, 0xf4, 0x4c, 0x13, 0x2f, 0x18, 0xd6, 0xe4, 0x1e, 0xf8, 0xcd, 0xdf, 0x85, 0xf2, 0x63,
+	0x15, 0x3a, 0x66, 0xde, 0xdf, 0x73, 0x79, 0x15, 0xbf, 0x87, 0xbb, 0xa7, 0xec, 0xf1, 0xbf, 0xfc,
+	0xef, 0xc3, 0xc5, 0xb1, 0x3f, 0x86, 0xce, 0xc0, 0xbd, 0xf6, 0xa9, 0x7f, 0x14, 0x80, 0xf1, 0x5b,
+	0x88, 0x4f, 0x25, 0xcc, 0x14, 0x53, 0x2b, 0xa4, 0x1c, 0xa5, 0x68, 0x90, 0x93, 0x47, 0xe0, 0xa1,
+	0x61, 0x4c, 0xc8, 0xad, 0x2c, 0x4a, 0xfe, 0xed, 0x22, 0xe9, 0x76, 0x9e, 0x88, 0x8a, 0xd3, 0x9d,
+	0x3a, 0x2e, 0xe1, 0xf2, 0xd0, 0x89, 0xf8, 0xba, 0xf7, 0xbb, 0x0d, 0x9e, 0x31, 0xd0, 0x7e, 0xfa,
+	0xa6, 0x1d, 0x22, 0x29, 0xf4, 0x64, 0x2b, 0xc4, 0xd2, 0x74, 0xd1, 0xcf, 0xee, 0x9c, 0x8a, 0x79,
+	0xa5, 0x05, 0xb4, 0xd3, 0xc5, 0x0b, 0xe8, 0x19, 0x4c, 0x2e, 0xa1, 0x87, 0x8a, 0xb5, 0xca, 0x1c,
+	0xe8, 0xd2, 0x0e, 0x90, 0x00, 0x5c, 0xde, 0x74, 0xcd, 0xba, 0x54, 0x7f, 0x6a, 0x5d, 0x21, 0x2a,
+	0x8e, 0xa1, 0x6b, 0x82, 0x3b, 0xa0, 0x7b, 0xfc, 0xc4, 0xd9, 0xb2, 0xeb, 0xf1, 0x46, 0xd7, 0xa3,
+	0x26, 0x74, 0x8f, 0x0f, 0x1e, 0x03, 0x1c, 0x7e, 0x8d, 0xf4, 0xe1, 0x66, 0x5e, 0xbc, 0x19, 0x4e,
+	0xf2, 0x71, 0x60, 0x11, 0x0f, 0x9c, 0xe9, 0xcb, 0xc0, 0x26, 0x17, 0x70, 0x5e, 0x4c, 0xe7, 0xe5,
+	0xb3, 0xe9, 0xeb, 0x62, 0x1c, 0x38, 0xc4, 0x87, 0xb3, 0xbc, 0x98, 0x3f, 0xa5, 0xc5, 0x70, 0x12,
+	0xb8, 0xa3, 0xf0, 0xe7, 0x26, 0xb2, 0xd7, 0x9b, 0xc8, 0xfe, 0xbd, 0x89, 0xec, 0x1f, 0xdb, 0xc8,
+	0x5a, 0x6f, 0x23, 0xeb, 0xd7, 0x36, 0xb2, 0x16, 0x9e, 0x79, 0x58, 0x0f, 0xff, 0x04, 0x00, 0x00,
+	0xff, 0xff, 0xdc, 0xac, 0xe0, 0x95, 0x7c, 0x02, 0x00, 0x00,
 }
 
 func (m *GetSharesByNamespaceRequest) Marshal() (dAtA []byte, err error) {
@@ -344,7 +408,7 @@ func (m *GetSharesByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *GetSharesByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetSharesByNamespacesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -354,30 +418,55 @@ func (m *GetSharesByNamespaceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetSharesByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetSharesByNamespacesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetSharesByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetSharesByNamespacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rows) > 0 {
-		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintShare(dAtA, i, uint64(size))
-			}
+	if len(m.NamespaceIds) > 0 {
+		for iNdEx := len(m.NamespaceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NamespaceIds[iNdEx])
+			copy(dAtA[i:], m.NamespaceIds[iNdEx])
+			i = encodeVarintShare(dAtA, i, uint64(len(m.NamespaceIds[iNdEx])))
 			i--
 			dAtA[i] = 0x12
 		}
 	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSharesByNamespaceStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharesByNamespaceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharesByNamespaceStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Status != 0 {
 		i = encodeVarintShare(dAtA, i, uint64(m.Status))
 		i--
@@ -386,7 +475,7 @@ func (m *GetSharesByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *Row) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceRowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -396,12 +485,12 @@ func (m *Row) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Row) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceRowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Row) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -450,6 +539,13 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Nodes) > 0 {
 		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Nodes[iNdEx])
@@ -500,25 +596,38 @@ func (m *GetSharesByNamespaceRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetSharesByNamespaceResponse) Size() (n int) {
+func (m *GetSharesByNamespacesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovShare(uint64(m.Status))
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
 	}
-	if len(m.Rows) > 0 {
-		for _, e := range m.Rows {
-			l = e.Size()
+	if len(m.NamespaceIds) > 0 {
+		for _, b := range m.NamespaceIds {
+			l = len(b)
 			n += 1 + l + sovShare(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Row) Size() (n int) {
+func (m *GetSharesByNamespaceStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovShare(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *NamespaceRowResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -555,6 +664,10 @@ func (m *Proof) Size() (n int) {
 			n += 1 + l + sovShare(uint64(l))
 		}
 	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
 	return n
 }
 
@@ -682,7 +795,7 @@ func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSharesByNamespaceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSharesByNamespacesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,17 +818,17 @@ func (m *GetSharesByNamespaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSharesByNamespaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSharesByNamespacesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSharesByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSharesByNamespacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShare
@@ -725,16 +838,31 @@ func (m *GetSharesByNamespaceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= StatusCode(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceIds", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowShare
@@ -744,26 +872,93 @@ func (m *GetSharesByNamespaceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthShare
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthShare
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rows = append(m.Rows, &Row{})
-			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NamespaceIds = append(m.NamespaceIds, make([]byte, postIndex-iNdEx))
+			copy(m.NamespaceIds[len(m.NamespaceIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSharesByNamespaceStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharesByNamespaceStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharesByNamespaceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShare(dAtA[iNdEx:])
@@ -785,7 +980,7 @@ func (m *GetSharesByNamespaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Row) Unmarshal(dAtA []byte) error {
+func (m *NamespaceRowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -808,10 +1003,10 @@ func (m *Row) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Row: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceRowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Row: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceRowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1002,6 +1197,40 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
 			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShare(dAtA[iNdEx:])
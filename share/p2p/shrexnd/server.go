@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/host"
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/celestiaorg/go-libp2p-messenger/serde"
+	"github.com/celestiaorg/nmt/namespace"
 
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
@@ -26,8 +28,9 @@ import (
 type Server struct {
 	cancel context.CancelFunc
 
-	host       host.Host
-	protocolID protocol.ID
+	host            host.Host
+	protocolID      protocol.ID
+	batchProtocolID protocol.ID
 
 	getter share.Getter
 	store  *eds.Store
@@ -44,12 +47,13 @@ func NewServer(params *Parameters, host host.Host, store *eds.Store, getter shar
 	}
 
 	srv := &Server{
-		getter:     getter,
-		store:      store,
-		host:       host,
-		params:     params,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		middleware: p2p.NewMiddleware(params.ConcurrencyLimit),
+		getter:          getter,
+		store:           store,
+		host:            host,
+		params:          params,
+		protocolID:      p2p.ProtocolID(params.NetworkID(), protocolString),
+		batchProtocolID: p2p.ProtocolID(params.NetworkID(), batchProtocolString),
+		middleware:      p2p.NewMiddleware(params.ConcurrencyLimit),
 	}
 
 	return srv, nil
@@ -64,6 +68,11 @@ func (srv *Server) Start(context.Context) error {
 		srv.handleNamespacedData(ctx, s)
 	}
 	srv.host.SetStreamHandler(srv.protocolID, srv.middleware.RateLimitHandler(handler))
+
+	batchHandler := func(s network.Stream) {
+		srv.handleNamespacedDataBatch(ctx, s)
+	}
+	srv.host.SetStreamHandler(srv.batchProtocolID, srv.middleware.RateLimitHandler(batchHandler))
 	return nil
 }
 
@@ -71,6 +80,7 @@ func (srv *Server) Start(context.Context) error {
 func (srv *Server) Stop(context.Context) error {
 	srv.cancel()
 	srv.host.RemoveStreamHandler(srv.protocolID)
+	srv.host.RemoveStreamHandler(srv.batchProtocolID)
 	return nil
 }
 
@@ -128,7 +138,109 @@ func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stre
 		return
 	}
 
-	shares, err := srv.getter.GetSharesByNamespace(ctx, dah, req.NamespaceId)
+	it, err := srv.getter.GetSharesByNamespaceStream(ctx, dah, req.NamespaceId)
+	if errors.Is(err, share.ErrNotFound) {
+		srv.respondNotFoundError(logger, stream)
+		return
+	}
+	if err != nil {
+		logger.Errorw("server: retrieving shares", "err", err)
+		srv.respondInternalError(logger, stream)
+		return
+	}
+	defer it.Close()
+
+	srv.streamRows(ctx, logger, stream, it)
+}
+
+// streamRows sends the OK status followed by every row read from the iterator in a separate
+// message, so that neither side has to keep the whole namespace in memory.
+func (srv *Server) streamRows(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	it share.NamespacedRowIterator,
+) {
+	if !srv.writeMessage(logger, stream, &pb.GetSharesByNamespaceStatusResponse{Status: pb.StatusCode_OK}) {
+		return
+	}
+	srv.metrics.ObserveRequests(1, p2p.StatusSuccess)
+
+	for {
+		row, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// the status is already sent, so the only way to signal the error is to reset the stream
+			logger.Errorw("server: retrieving row", "err", err)
+			stream.Reset() //nolint:errcheck
+			return
+		}
+
+		if !srv.writeMessage(logger, stream, namespacedRowToResponse(row)) {
+			return
+		}
+	}
+
+	if err := stream.Close(); err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
+}
+
+func (srv *Server) handleNamespacedDataBatch(ctx context.Context, stream network.Stream) {
+	logger := log.With("peer", stream.Conn().RemotePeer())
+	logger.Debug("server: handling nd batch request")
+
+	srv.observeRateLimitedRequests()
+
+	err := stream.SetReadDeadline(time.Now().Add(srv.params.ServerReadTimeout))
+	if err != nil {
+		logger.Debugw("server: setting read deadline", "err", err)
+	}
+
+	var req pb.GetSharesByNamespacesRequest
+	_, err = serde.Read(stream, &req)
+	if err != nil {
+		logger.Warnw("server: reading request", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+	logger = logger.With("namespaces", len(req.NamespaceIds), "hash", share.DataHash(req.RootHash).String())
+	logger.Debugw("server: new batch request")
+
+	err = stream.CloseRead()
+	if err != nil {
+		logger.Debugw("server: closing read side of the stream", "err", err)
+	}
+
+	err = validateBatchRequest(&req)
+	if err != nil {
+		logger.Debugw("server: invalid request", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+	defer cancel()
+
+	dah, err := srv.store.GetDAH(ctx, req.RootHash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			srv.respondNotFoundError(logger, stream)
+			return
+		}
+		logger.Errorw("server: retrieving DAH", "err", err)
+		srv.respondInternalError(logger, stream)
+		return
+	}
+
+	nIDs := make([]namespace.ID, len(req.NamespaceIds))
+	for i, nID := range req.NamespaceIds {
+		nIDs[i] = nID
+	}
+
+	shares, err := srv.getter.GetSharesByNamespaces(ctx, dah, nIDs)
 	if errors.Is(err, share.ErrNotFound) {
 		srv.respondNotFoundError(logger, stream)
 		return
@@ -139,8 +251,34 @@ func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stre
 		return
 	}
 
-	resp := namespacedSharesToResponse(shares)
-	srv.respond(logger, stream, resp)
+	if !srv.writeMessage(logger, stream, &pb.GetSharesByNamespaceStatusResponse{Status: pb.StatusCode_OK}) {
+		return
+	}
+	srv.metrics.ObserveRequests(1, p2p.StatusSuccess)
+
+	// every namespace is sent in the order of the request with its own status
+	for _, nID := range nIDs {
+		rows := shares.Get(nID)
+		if rows == nil {
+			if !srv.writeMessage(logger, stream, &pb.GetSharesByNamespaceStatusResponse{Status: pb.StatusCode_NOT_FOUND}) {
+				return
+			}
+			continue
+		}
+
+		if !srv.writeMessage(logger, stream, &pb.GetSharesByNamespaceStatusResponse{Status: pb.StatusCode_OK}) {
+			return
+		}
+		for _, row := range rows {
+			if !srv.writeMessage(logger, stream, namespacedRowToResponse(row)) {
+				return
+			}
+		}
+	}
+
+	if err := stream.Close(); err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
 }
 
 // validateRequest checks correctness of the request
@@ -155,68 +293,79 @@ func validateRequest(req pb.GetSharesByNamespaceRequest) error {
 	return nil
 }
 
-// respondNotFoundError sends internal error response to client
-func (srv *Server) respondNotFoundError(logger *zap.SugaredLogger, stream network.Stream) {
-	resp := &pb.GetSharesByNamespaceResponse{
-		Status: pb.StatusCode_NOT_FOUND,
+// validateBatchRequest checks correctness of the batch request
+func validateBatchRequest(req *pb.GetSharesByNamespacesRequest) error {
+	if len(req.RootHash) != sha256.Size {
+		return fmt.Errorf("incorrect root hash length: %v", len(req.RootHash))
 	}
-	srv.respond(logger, stream, resp)
+	if len(req.NamespaceIds) == 0 || len(req.NamespaceIds) > MaxNamespacesPerRequest {
+		return fmt.Errorf("incorrect amount of namespace ids: %v", len(req.NamespaceIds))
+	}
+	for _, nID := range req.NamespaceIds {
+		if len(nID) != ipld.NamespaceSize {
+			return fmt.Errorf("incorrect namespace id length: %v", len(nID))
+		}
+	}
+
+	return nil
+}
+
+// respondNotFoundError sends not found response to client
+func (srv *Server) respondNotFoundError(logger *zap.SugaredLogger, stream network.Stream) {
+	srv.respondStatus(logger, stream, pb.StatusCode_NOT_FOUND)
 }
 
 // respondInternalError sends internal error response to client
 func (srv *Server) respondInternalError(logger *zap.SugaredLogger, stream network.Stream) {
-	resp := &pb.GetSharesByNamespaceResponse{
-		Status: pb.StatusCode_INTERNAL,
-	}
-	srv.respond(logger, stream, resp)
+	srv.respondStatus(logger, stream, pb.StatusCode_INTERNAL)
 }
 
-// namespacedSharesToResponse encodes shares into proto and sends it to client with OK status code
-func namespacedSharesToResponse(shares share.NamespacedShares) *pb.GetSharesByNamespaceResponse {
-	rows := make([]*pb.Row, 0, len(shares))
-	for _, row := range shares {
-		proof := &pb.Proof{
-			Start: int64(row.Proof.Start()),
-			End:   int64(row.Proof.End()),
-			Nodes: row.Proof.Nodes(),
-		}
-
-		row := &pb.Row{
-			Shares: row.Shares,
-			Proof:  proof,
+// namespacedRowToResponse encodes the row into proto
+func namespacedRowToResponse(row share.NamespacedRow) *pb.NamespaceRowResponse {
+	resp := &pb.NamespaceRowResponse{
+		Shares: row.Shares,
+	}
+	if row.Proof != nil {
+		resp.Proof = &pb.Proof{
+			Start:    int64(row.Proof.Start()),
+			End:      int64(row.Proof.End()),
+			Nodes:    row.Proof.Nodes(),
+			LeafHash: row.Proof.LeafHash(),
 		}
+	}
+	return resp
+}
 
-		rows = append(rows, row)
+// respondStatus sends the error status as the only message of the response and closes the stream.
+func (srv *Server) respondStatus(logger *zap.SugaredLogger, stream network.Stream, status pb.StatusCode) {
+	if !srv.writeMessage(logger, stream, &pb.GetSharesByNamespaceStatusResponse{Status: status}) {
+		return
 	}
 
-	return &pb.GetSharesByNamespaceResponse{
-		Status: pb.StatusCode_OK,
-		Rows:   rows,
+	switch status {
+	case pb.StatusCode_NOT_FOUND:
+		srv.metrics.ObserveRequests(1, p2p.StatusNotFound)
+	case pb.StatusCode_INTERNAL:
+		srv.metrics.ObserveRequests(1, p2p.StatusInternalErr)
+	}
+	if err := stream.Close(); err != nil {
+		logger.Debugw("server: closing stream", "err", err)
 	}
 }
 
-func (srv *Server) respond(logger *zap.SugaredLogger, stream network.Stream, resp *pb.GetSharesByNamespaceResponse) {
+// writeMessage writes a single message of the response with its own write deadline. It resets the
+// stream and returns false if the message could not be written.
+func (srv *Server) writeMessage(logger *zap.SugaredLogger, stream network.Stream, msg serde.Message) bool {
 	err := stream.SetWriteDeadline(time.Now().Add(srv.params.ServerWriteTimeout))
 	if err != nil {
 		logger.Debugw("server: setting write deadline", "err", err)
 	}
 
-	_, err = serde.Write(stream, resp)
+	_, err = serde.Write(stream, msg)
 	if err != nil {
 		logger.Warnw("server: writing response", "err", err)
 		stream.Reset() //nolint:errcheck
-		return
-	}
-
-	switch {
-	case resp.Status == pb.StatusCode_OK:
-		srv.metrics.ObserveRequests(1, p2p.StatusSuccess)
-	case resp.Status == pb.StatusCode_NOT_FOUND:
-		srv.metrics.ObserveRequests(1, p2p.StatusNotFound)
-	case resp.Status == pb.StatusCode_INTERNAL:
-		srv.metrics.ObserveRequests(1, p2p.StatusInternalErr)
-	}
-	if err = stream.Close(); err != nil {
-		logger.Debugw("server: closing stream", "err", err)
+		return false
 	}
+	return true
 }
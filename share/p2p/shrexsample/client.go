@@ -0,0 +1,238 @@
+package shrexsample
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/minio/sha256-simd"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
+	"github.com/celestiaorg/nmt"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsample/pb"
+)
+
+// Client implements client side of shrex/sample protocol to obtain shares at the given
+// coordinates from remote peers.
+type Client struct {
+	params     *Parameters
+	protocolID protocol.ID
+
+	host    host.Host
+	metrics *p2p.Metrics
+}
+
+// NewClient creates a new shrEx/sample client
+func NewClient(params *Parameters, host host.Host) (*Client, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("shrex-sample: client creation failed: %w", err)
+	}
+
+	return &Client{
+		host:       host,
+		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
+		params:     params,
+	}, nil
+}
+
+// RequestSamples requests the shares at the given coordinates from the given peer.
+// Returns the shares in the order of the coordinates with their inclusion verified against the
+// share.Root.
+func (c *Client) RequestSamples(
+	ctx context.Context,
+	root *share.Root,
+	coords []share.Coordinate,
+	peer peer.ID,
+) ([]share.Share, error) {
+	shares, err := c.doRequest(ctx, root, coords, peer)
+	if err == nil {
+		return shares, err
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		c.metrics.ObserveRequests(1, p2p.StatusTimeout)
+		return nil, err
+	}
+	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
+	// unwrap to a ctx err
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
+			c.metrics.ObserveRequests(1, p2p.StatusTimeout)
+			return nil, context.DeadlineExceeded
+		}
+	}
+	if err != p2p.ErrNotFound {
+		log.Warnw("client-sample: peer returned err", "err", err)
+	}
+	return nil, err
+}
+
+func (c *Client) doRequest(
+	ctx context.Context,
+	root *share.Root,
+	coords []share.Coordinate,
+	peerID peer.ID,
+) ([]share.Share, error) {
+	req, err := newRequest(root, coords)
+	if err != nil {
+		return nil, fmt.Errorf("client-sample: %w", err)
+	}
+
+	stream, err := c.host.NewStream(ctx, peerID, c.protocolID)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	c.setStreamDeadlines(ctx, stream)
+
+	_, err = serde.Write(stream, req)
+	if err != nil {
+		stream.Reset() //nolint:errcheck
+		return nil, fmt.Errorf("client-sample: writing request: %w", err)
+	}
+
+	err = stream.CloseWrite()
+	if err != nil {
+		log.Debugw("client-sample: closing write side of the stream", "err", err)
+	}
+
+	var resp pb.GetSamplesResponse
+	_, err = serde.Read(stream, &resp)
+	if err != nil {
+		// server is overloaded and closed the stream
+		if errors.Is(err, io.EOF) {
+			c.metrics.ObserveRequests(1, p2p.StatusRateLimited)
+			return nil, p2p.ErrNotFound
+		}
+		stream.Reset() //nolint:errcheck
+		return nil, fmt.Errorf("client-sample: reading response: %w", err)
+	}
+
+	if err = c.statusToErr(resp.Status); err != nil {
+		return nil, fmt.Errorf("client-sample: response code is not OK: %w", err)
+	}
+
+	shares, err := verifySamples(root, coords, resp.Samples)
+	if err != nil {
+		// the peer has responded with the data that does not match the root
+		return nil, fmt.Errorf("client-sample: verifying response: %w: %w", p2p.ErrInvalidResponse, err)
+	}
+	return shares, nil
+}
+
+func newRequest(root *share.Root, coords []share.Coordinate) (*pb.GetSamplesRequest, error) {
+	if len(coords) == 0 || len(coords) > MaxSamplesPerRequest {
+		return nil, fmt.Errorf("amount of coordinates must be within [1, %d], got %d",
+			MaxSamplesPerRequest, len(coords))
+	}
+
+	width := len(root.RowsRoots)
+	req := &pb.GetSamplesRequest{
+		RootHash:    root.Hash(),
+		Coordinates: make([]*pb.Coordinate, len(coords)),
+	}
+	for i, coord := range coords {
+		if coord.Row < 0 || coord.Row >= width || coord.Col < 0 || coord.Col >= width {
+			return nil, fmt.Errorf("coordinate (%d, %d) is out of the square of width %d", coord.Row, coord.Col, width)
+		}
+		req.Coordinates[i] = &pb.Coordinate{Row: uint32(coord.Row), Col: uint32(coord.Col)}
+	}
+	return req, nil
+}
+
+// verifySamples checks every sample is included into the root of its row and converts them to
+// shares.
+func verifySamples(root *share.Root, coords []share.Coordinate, samples []*pb.Sample) ([]share.Share, error) {
+	if len(samples) != len(coords) {
+		return nil, fmt.Errorf("expected %d samples, got %d", len(coords), len(samples))
+	}
+
+	odsWidth := len(root.RowsRoots) / 2
+	shares := make([]share.Share, len(samples))
+	for i, sample := range samples {
+		coord := coords[i]
+		if sample.Proof == nil || len(sample.Share) != share.Size {
+			return nil, fmt.Errorf("malformed sample at (%d, %d)", coord.Row, coord.Col)
+		}
+
+		// shares outside the original data square are namespaced with the parity namespace
+		nID := share.ID(sample.Share)
+		if coord.Row >= odsWidth || coord.Col >= odsWidth {
+			nID = appconsts.ParitySharesNamespaceID
+		}
+
+		proof := nmt.NewInclusionProof(
+			int(sample.Proof.Start),
+			int(sample.Proof.End),
+			sample.Proof.Nodes,
+			ipld.NMTIgnoreMaxNamespace,
+		)
+		if proof.Start() != coord.Col || proof.End() != coord.Col+1 {
+			return nil, fmt.Errorf("proof of sample at (%d, %d) is for a different position", coord.Row, coord.Col)
+		}
+		if !proof.VerifyInclusion(sha256.New(), nID, [][]byte{sample.Share}, root.RowsRoots[coord.Row]) {
+			return nil, fmt.Errorf("sample at (%d, %d) is not included into the row root", coord.Row, coord.Col)
+		}
+		shares[i] = sample.Share
+	}
+	return shares, nil
+}
+
+func (c *Client) setStreamDeadlines(ctx context.Context, stream network.Stream) {
+	// set read/write deadline to use context deadline if it exists
+	deadline, ok := ctx.Deadline()
+	if ok {
+		err := stream.SetDeadline(deadline)
+		if err == nil {
+			return
+		}
+		log.Debugw("client-sample: set stream deadline", "err", err)
+	}
+
+	// if deadline not set, client read deadline defaults to server write deadline
+	if c.params.ServerWriteTimeout != 0 {
+		err := stream.SetReadDeadline(time.Now().Add(c.params.ServerWriteTimeout))
+		if err != nil {
+			log.Debugw("client-sample: set read deadline", "err", err)
+		}
+	}
+
+	// if deadline not set, client write deadline defaults to server read deadline
+	if c.params.ServerReadTimeout != 0 {
+		err := stream.SetWriteDeadline(time.Now().Add(c.params.ServerReadTimeout))
+		if err != nil {
+			log.Debugw("client-sample: set write deadline", "err", err)
+		}
+	}
+}
+
+func (c *Client) statusToErr(code pb.StatusCode) error {
+	switch code {
+	case pb.StatusCode_OK:
+		c.metrics.ObserveRequests(1, p2p.StatusSuccess)
+		return nil
+	case pb.StatusCode_NOT_FOUND:
+		c.metrics.ObserveRequests(1, p2p.StatusNotFound)
+		return p2p.ErrNotFound
+	case pb.StatusCode_INVALID:
+		log.Debug("client-sample: invalid request")
+		fallthrough
+	case pb.StatusCode_INTERNAL:
+		fallthrough
+	default:
+		return p2p.ErrInvalidResponse
+	}
+}
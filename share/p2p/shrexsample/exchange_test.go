@@ -0,0 +1,123 @@
+package shrexsample
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+)
+
+func TestExchange_RequestSamples(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	edsStore, client, server := makeExchange(t)
+	require.NoError(t, edsStore.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	eds := share.RandEDS(t, 4)
+	dah := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+
+	// cover every quadrant of the EDS, including the parity ones
+	width := int(eds.Width())
+	coords := make([]share.Coordinate, 0, width*width)
+	for row := 0; row < width; row++ {
+		for col := 0; col < width; col++ {
+			coords = append(coords, share.Coordinate{Row: row, Col: col})
+		}
+	}
+
+	shares, err := client.RequestSamples(ctx, &dah, coords, server.host.ID())
+	require.NoError(t, err)
+	require.Len(t, shares, len(coords))
+	for i, coord := range coords {
+		require.Equal(t, eds.GetCell(uint(coord.Row), uint(coord.Col)), shares[i])
+	}
+
+	t.Run("CAR_not_exist", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		eds := share.RandEDS(t, 4)
+		dah := da.NewDataAvailabilityHeader(eds)
+		_, err := client.RequestSamples(ctx, &dah, coords[:1], server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrNotFound)
+	})
+
+	t.Run("invalid_coordinates", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		_, err := client.RequestSamples(ctx, &dah, nil, server.host.ID())
+		require.Error(t, err)
+		_, err = client.RequestSamples(ctx, &dah, []share.Coordinate{{Row: width}}, server.host.ID())
+		require.Error(t, err)
+	})
+}
+
+func TestVerifySamples(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	edsStore := newStore(t)
+	require.NoError(t, edsStore.Start(ctx))
+
+	square := share.RandEDS(t, 4)
+	dah := da.NewDataAvailabilityHeader(square)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), square))
+
+	bs, err := edsStore.CARBlockstore(ctx, dah.Hash())
+	require.NoError(t, err)
+
+	coords := []share.Coordinate{{Row: 1, Col: 2}, {Row: 6, Col: 1}}
+	req, err := newRequest(&dah, coords)
+	require.NoError(t, err)
+	samples, err := getSamples(ctx, eds.NewBlockGetter(bs), &dah, req.Coordinates)
+	require.NoError(t, err)
+
+	_, err = verifySamples(&dah, coords, samples)
+	require.NoError(t, err)
+
+	// samples swapped between coordinates must not pass the verification
+	_, err = verifySamples(&dah, []share.Coordinate{coords[1], coords[0]}, samples)
+	require.Error(t, err)
+
+	// tampered share must not pass the verification
+	samples[0].Share[len(samples[0].Share)-1] ^= 0xFF
+	_, err = verifySamples(&dah, coords, samples)
+	require.Error(t, err)
+}
+
+func newStore(t *testing.T) *eds.Store {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	store, err := eds.NewStore(tmpDir, ds)
+	require.NoError(t, err)
+	return store
+}
+
+func makeExchange(t *testing.T) (*eds.Store, *Client, *Server) {
+	t.Helper()
+	store := newStore(t)
+	net, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	hosts := net.Hosts()
+
+	client, err := NewClient(DefaultParameters(), hosts[0])
+	require.NoError(t, err)
+	server, err := NewServer(DefaultParameters(), hosts[1], store)
+	require.NoError(t, err)
+
+	return store, client, server
+}
@@ -0,0 +1,41 @@
+package shrexsample
+
+import (
+	"fmt"
+
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/celestiaorg/celestia-node/share/p2p"
+)
+
+const protocolString = "/shrex/sample/0.0.1"
+
+// MaxSamplesPerRequest limits the amount of coordinates that can be requested at once.
+const MaxSamplesPerRequest = 256
+
+var log = logging.Logger("shrex/sample")
+
+// Parameters is the set of parameters that must be configured for the shrex/sample protocol.
+type Parameters = p2p.Parameters
+
+func DefaultParameters() *Parameters {
+	return p2p.DefaultParameters()
+}
+
+func (c *Client) WithMetrics() error {
+	metrics, err := p2p.InitClientMetrics("sample")
+	if err != nil {
+		return fmt.Errorf("shrex/sample: init Metrics: %w", err)
+	}
+	c.metrics = metrics
+	return nil
+}
+
+func (srv *Server) WithMetrics() error {
+	metrics, err := p2p.InitServerMetrics("sample")
+	if err != nil {
+		return fmt.Errorf("shrex/sample: init Metrics: %w", err)
+	}
+	srv.metrics = metrics
+	return nil
+}
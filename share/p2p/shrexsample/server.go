@@ -0,0 +1,262 @@
+package shrexsample
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/minio/sha256-simd"
+	"go.uber.org/zap"
+
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsample/pb"
+)
+
+// Server implements server side of shrex/sample protocol to serve shares at the requested
+// coordinates together with the proofs of their inclusion to remote peers.
+type Server struct {
+	cancel context.CancelFunc
+
+	host       host.Host
+	protocolID protocol.ID
+
+	store *eds.Store
+
+	params     *Parameters
+	middleware *p2p.Middleware
+	metrics    *p2p.Metrics
+}
+
+// NewServer creates new Server
+func NewServer(params *Parameters, host host.Host, store *eds.Store) (*Server, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("shrex-sample: server creation failed: %w", err)
+	}
+
+	srv := &Server{
+		store:      store,
+		host:       host,
+		params:     params,
+		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
+		middleware: p2p.NewMiddleware(params.ConcurrencyLimit),
+	}
+
+	return srv, nil
+}
+
+// Start starts the server
+func (srv *Server) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.cancel = cancel
+
+	handler := func(s network.Stream) {
+		srv.handleSamples(ctx, s)
+	}
+	srv.host.SetStreamHandler(srv.protocolID, srv.middleware.RateLimitHandler(handler))
+	return nil
+}
+
+// Stop stops the server
+func (srv *Server) Stop(context.Context) error {
+	srv.cancel()
+	srv.host.RemoveStreamHandler(srv.protocolID)
+	return nil
+}
+
+func (srv *Server) observeRateLimitedRequests() {
+	numRateLimited := srv.middleware.DrainCounter()
+	if numRateLimited > 0 {
+		srv.metrics.ObserveRequests(numRateLimited, p2p.StatusRateLimited)
+	}
+}
+
+func (srv *Server) handleSamples(ctx context.Context, stream network.Stream) {
+	logger := log.With("peer", stream.Conn().RemotePeer())
+	logger.Debug("server: handling sample request")
+
+	srv.observeRateLimitedRequests()
+
+	err := stream.SetReadDeadline(time.Now().Add(srv.params.ServerReadTimeout))
+	if err != nil {
+		logger.Debugw("server: setting read deadline", "err", err)
+	}
+
+	var req pb.GetSamplesRequest
+	_, err = serde.Read(stream, &req)
+	if err != nil {
+		logger.Warnw("server: reading request", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+	logger = logger.With("hash", share.DataHash(req.RootHash).String(), "samples", len(req.Coordinates))
+	logger.Debugw("server: new request")
+
+	err = stream.CloseRead()
+	if err != nil {
+		logger.Debugw("server: closing read side of the stream", "err", err)
+	}
+
+	err = validateRequest(&req)
+	if err != nil {
+		logger.Debugw("server: invalid request", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
+	defer cancel()
+
+	dah, err := srv.store.GetDAH(ctx, req.RootHash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			srv.respondNotFoundError(logger, stream)
+			return
+		}
+		logger.Errorw("server: retrieving DAH", "err", err)
+		srv.respondInternalError(logger, stream)
+		return
+	}
+
+	width := len(dah.RowsRoots)
+	for _, coord := range req.Coordinates {
+		if int(coord.Row) >= width || int(coord.Col) >= width {
+			logger.Debugw("server: coordinate out of bounds", "row", coord.Row, "col", coord.Col, "width", width)
+			stream.Reset() //nolint:errcheck
+			return
+		}
+	}
+
+	bs, err := srv.store.CARBlockstore(ctx, req.RootHash)
+	if err != nil {
+		if errors.Is(err, eds.ErrNotFound) {
+			srv.respondNotFoundError(logger, stream)
+			return
+		}
+		logger.Errorw("server: retrieving blockstore", "err", err)
+		srv.respondInternalError(logger, stream)
+		return
+	}
+
+	samples, err := getSamples(ctx, eds.NewBlockGetter(bs), dah, req.Coordinates)
+	if err != nil {
+		logger.Errorw("server: retrieving samples", "err", err)
+		srv.respondInternalError(logger, stream)
+		return
+	}
+
+	srv.respond(logger, stream, &pb.GetSamplesResponse{
+		Status:  pb.StatusCode_OK,
+		Samples: samples,
+	})
+}
+
+// getSamples collects the shares at the given coordinates together with the proofs of their
+// inclusion into the roots of their rows.
+func getSamples(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	dah *share.Root,
+	coords []*pb.Coordinate,
+) ([]*pb.Sample, error) {
+	width := len(dah.RowsRoots)
+	samples := make([]*pb.Sample, len(coords))
+	for i, coord := range coords {
+		row, col := int(coord.Row), int(coord.Col)
+		rootCid := ipld.MustCidFromNamespacedSha256(dah.RowsRoots[row])
+
+		leaf, err := ipld.GetLeaf(ctx, bg, rootCid, col, width)
+		if err != nil {
+			return nil, fmt.Errorf("getting share at (%d, %d): %w", row, col, err)
+		}
+		path, err := ipld.GetProof(ctx, bg, rootCid, []cid.Cid{}, col, width)
+		if err != nil {
+			return nil, fmt.Errorf("getting proof of share at (%d, %d): %w", row, col, err)
+		}
+
+		// the path is collected from the leaf up, while the nmt proof expects nodes from the root
+		nodes := make([][]byte, 0, len(path))
+		for j := len(path) - 1; j >= 0; j-- {
+			nodes = append(nodes, ipld.NamespacedSha256FromCID(path[j]))
+		}
+
+		samples[i] = &pb.Sample{
+			Share: leaf.RawData()[ipld.NamespaceSize:],
+			Proof: &pb.Proof{
+				Start: int64(col),
+				End:   int64(col + 1),
+				Nodes: nodes,
+			},
+		}
+	}
+	return samples, nil
+}
+
+// validateRequest checks correctness of the request
+func validateRequest(req *pb.GetSamplesRequest) error {
+	if len(req.RootHash) != sha256.Size {
+		return fmt.Errorf("incorrect root hash length: %v", len(req.RootHash))
+	}
+	if len(req.Coordinates) == 0 || len(req.Coordinates) > MaxSamplesPerRequest {
+		return fmt.Errorf("incorrect amount of coordinates: %v", len(req.Coordinates))
+	}
+	for _, coord := range req.Coordinates {
+		if coord == nil {
+			return errors.New("empty coordinate")
+		}
+	}
+
+	return nil
+}
+
+// respondNotFoundError sends not found response to client
+func (srv *Server) respondNotFoundError(logger *zap.SugaredLogger, stream network.Stream) {
+	resp := &pb.GetSamplesResponse{
+		Status: pb.StatusCode_NOT_FOUND,
+	}
+	srv.respond(logger, stream, resp)
+}
+
+// respondInternalError sends internal error response to client
+func (srv *Server) respondInternalError(logger *zap.SugaredLogger, stream network.Stream) {
+	resp := &pb.GetSamplesResponse{
+		Status: pb.StatusCode_INTERNAL,
+	}
+	srv.respond(logger, stream, resp)
+}
+
+func (srv *Server) respond(logger *zap.SugaredLogger, stream network.Stream, resp *pb.GetSamplesResponse) {
+	err := stream.SetWriteDeadline(time.Now().Add(srv.params.ServerWriteTimeout))
+	if err != nil {
+		logger.Debugw("server: setting write deadline", "err", err)
+	}
+
+	_, err = serde.Write(stream, resp)
+	if err != nil {
+		logger.Warnw("server: writing response", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+
+	switch {
+	case resp.Status == pb.StatusCode_OK:
+		srv.metrics.ObserveRequests(1, p2p.StatusSuccess)
+	case resp.Status == pb.StatusCode_NOT_FOUND:
+		srv.metrics.ObserveRequests(1, p2p.StatusNotFound)
+	case resp.Status == pb.StatusCode_INTERNAL:
+		srv.metrics.ObserveRequests(1, p2p.StatusInternalErr)
+	}
+	if err = stream.Close(); err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
+}
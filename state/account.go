@@ -0,0 +1,116 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// ErrUnknownAccount is returned when transactions are requested to be signed with an account
+// that is not registered with the CoreAccessor.
+var ErrUnknownAccount = errors.New("state: unknown account")
+
+// Account is a keyring account transactions can be signed with.
+type Account struct {
+	Name    string     `json:"name"`
+	Address AccAddress `json:"address"`
+}
+
+// TxOptions customizes the transactions submitted through the CoreAccessor. Nil options select
+// the defaults.
+type TxOptions struct {
+	// Account is the name of the keyring account the transaction is signed with. Empty name selects
+	// the default account.
+	Account string `json:"account,omitempty"`
+	// FeeGranter is the account paying the fee from the allowance it granted to the signing account
+	// with GrantFee. The signing account pays the fee, if empty.
+	FeeGranter AccAddress `json:"fee_granter,omitempty"`
+}
+
+// builderOptions returns the options building the transaction accordingly.
+func (opts *TxOptions) builderOptions() []apptypes.TxBuilderOption {
+	if opts == nil || opts.FeeGranter.Empty() {
+		return nil
+	}
+	return []apptypes.TxBuilderOption{apptypes.SetFeeGranter(opts.FeeGranter)}
+}
+
+// AccountGuard decides whether transactions may be signed with the keyring account of the given
+// name in the given context, e.g. based on the permissions of the caller.
+type AccountGuard func(ctx context.Context, account string) error
+
+// WithAccounts registers the signers of additional keyring accounts, that can be selected to sign
+// transactions instead of the default one.
+func WithAccounts(signers ...*apptypes.KeyringSigner) Option {
+	return func(ca *CoreAccessor) {
+		ca.accounts = append(ca.accounts, signers...)
+	}
+}
+
+// WithAccountGuard sets the guard consulted every time transactions are signed.
+func WithAccountGuard(guard AccountGuard) Option {
+	return func(ca *CoreAccessor) {
+		ca.guard = guard
+	}
+}
+
+// Accounts returns the keyring accounts transactions can be signed with. The default account
+// comes first.
+func (ca *CoreAccessor) Accounts(context.Context) ([]Account, error) {
+	accounts := make([]Account, 0, len(ca.accounts)+1)
+	for _, signer := range append([]*apptypes.KeyringSigner{ca.signer}, ca.accounts...) {
+		rec := signer.GetSignerInfo()
+		addr, err := rec.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, Account{Name: rec.Name, Address: addr})
+	}
+	return accounts, nil
+}
+
+// startTxManagers creates the managers signing and submitting transactions for every account. The
+// manager of the default account is kept under the empty name.
+func (ca *CoreAccessor) startTxManagers(rpcCli txClient) {
+	ca.txs = make(map[string]*txManager, len(ca.accounts)+1)
+	ca.txs[""] = newTxManager(ca.signer, ca.coreConn, rpcCli)
+	for _, signer := range ca.accounts {
+		ca.txs[signer.GetSignerInfo().Name] = newTxManager(signer, ca.coreConn, rpcCli)
+	}
+}
+
+// stopTxManagers stops watching the pending transactions of every account.
+func (ca *CoreAccessor) stopTxManagers() {
+	for _, txs := range ca.txs {
+		txs.stop()
+	}
+}
+
+// txManager returns the manager of the account selected by the given options, ensuring the account
+// is allowed to sign in the given context.
+func (ca *CoreAccessor) txManager(ctx context.Context, opts *TxOptions) (*txManager, error) {
+	var account string
+	if opts != nil {
+		account = opts.Account
+	}
+	if account != "" && account == ca.signer.GetSignerInfo().Name {
+		account = ""
+	}
+	txs, ok := ca.txs[account]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, account)
+	}
+	if ca.guard == nil {
+		return txs, nil
+	}
+
+	if account == "" {
+		account = ca.signer.GetSignerInfo().Name
+	}
+	if err := ca.guard(ctx, account); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
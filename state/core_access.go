@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/api/tendermint/abci"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -17,11 +16,12 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/client/http"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
-	"github.com/celestiaorg/celestia-app/x/blob"
 	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	libhead "github.com/celestiaorg/go-header"
 	"github.com/celestiaorg/nmt/namespace"
@@ -43,12 +43,21 @@ type CoreAccessor struct {
 	signer *apptypes.KeyringSigner
 	getter libhead.Head[*header.ExtendedHeader]
 
-	queryCli   banktypes.QueryClient
-	stakingCli stakingtypes.QueryClient
-	rpcCli     rpcclient.ABCIClient
+	// accounts are the signers of the keyring accounts, besides the default one, that can be
+	// selected to sign transactions
+	accounts []*apptypes.KeyringSigner
+	guard    AccountGuard
+	// txs maps the names of the accounts to the managers submitting their transactions
+	txs map[string]*txManager
+
+	queryCli banktypes.QueryClient
+	rpcCli   rpcclient.ABCIClient
 
 	prt *merkle.ProofRuntime
 
+	// gasPrice is the price in utia paid per unit of gas, when the fee is not given
+	gasPrice float64
+
 	coreConn *grpc.ClientConn
 	coreIP   string
 	rpcPort  string
@@ -67,19 +76,25 @@ func NewCoreAccessor(
 	coreIP,
 	rpcPort string,
 	grpcPort string,
+	opts ...Option,
 ) *CoreAccessor {
 	// create verifier
 	prt := merkle.DefaultProofRuntime()
 	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
-	return &CoreAccessor{
+	ca := &CoreAccessor{
 		signer:   signer,
 		getter:   getter,
 		coreIP:   coreIP,
 		rpcPort:  rpcPort,
 		grpcPort: grpcPort,
 		prt:      prt,
+		gasPrice: DefaultGasPrice,
+	}
+	for _, opt := range opts {
+		opt(ca)
 	}
+	return ca
 }
 
 func (ca *CoreAccessor) Start(ctx context.Context) error {
@@ -98,15 +113,14 @@ func (ca *CoreAccessor) Start(ctx context.Context) error {
 	// create the query client
 	queryCli := banktypes.NewQueryClient(ca.coreConn)
 	ca.queryCli = queryCli
-	// create the staking query client
-	stakingCli := stakingtypes.NewQueryClient(ca.coreConn)
-	ca.stakingCli = stakingCli
 	// create ABCI query client
 	cli, err := http.New(fmt.Sprintf("http://%s:%s", ca.coreIP, ca.rpcPort), "/websocket")
 	if err != nil {
 		return err
 	}
 	ca.rpcCli = cli
+	// create the managers signing and submitting transactions
+	ca.startTxManagers(cli)
 
 	return nil
 }
@@ -121,6 +135,7 @@ func (ca *CoreAccessor) Stop(context.Context) error {
 		return nil
 	}
 	defer ca.cancelCtx()
+	ca.stopTxManagers()
 
 	// close out core connection
 	err := ca.coreConn.Close()
@@ -138,40 +153,81 @@ func (ca *CoreAccessor) cancelCtx() {
 	ca.cancel = nil
 }
 
-func (ca *CoreAccessor) constructSignedTx(
+func (ca *CoreAccessor) SubmitPayForBlob(
 	ctx context.Context,
-	msg sdktypes.Msg,
-	opts ...apptypes.TxBuilderOption,
-) ([]byte, error) {
-	// should be called first in order to make a valid tx
-	err := ca.signer.QueryAccountNumber(ctx, ca.coreConn)
-	if err != nil {
-		return nil, err
-	}
-
-	tx, err := ca.signer.BuildSignedTx(ca.signer.NewTxBuilder(opts...), msg)
-	if err != nil {
-		return nil, err
-	}
-	return ca.signer.EncodeTx(tx)
+	nID namespace.ID,
+	data []byte,
+	fee Int,
+	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.SubmitPayForBlobWithOptions(ctx, nID, data, fee, gasLim, nil)
 }
 
-func (ca *CoreAccessor) SubmitPayForBlob(
+// SubmitPayForBlobWithOptions is SubmitPayForBlob customized with the given options.
+func (ca *CoreAccessor) SubmitPayForBlobWithOptions(
 	ctx context.Context,
 	nID namespace.ID,
 	data []byte,
 	fee Int,
 	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	b := &apptypes.Blob{NamespaceId: nID, Data: data, ShareVersion: uint32(appconsts.DefaultShareVersion)}
-	response, err := blob.SubmitPayForBlob(
-		ctx,
-		ca.signer,
-		ca.coreConn,
-		[]*apptypes.Blob{b},
-		apptypes.SetGasLimit(gasLim),
-		withFee(fee),
-	)
+	return ca.SubmitPayForBlobsWithOptions(ctx, fee, gasLim, []*apptypes.Blob{b}, opts)
+}
+
+// SubmitPayForBlobs builds, signs and submits a single PayForBlob transaction
+// paying for all the given blobs and waits for its inclusion. Zero gasLim is estimated
+// with EstimateGasForBlobs and zero fee is derived from the gas limit and the configured
+// gas price.
+func (ca *CoreAccessor) SubmitPayForBlobs(
+	ctx context.Context,
+	fee Int,
+	gasLim uint64,
+	blobs []*apptypes.Blob,
+) (*TxResponse, error) {
+	return ca.SubmitPayForBlobsWithOptions(ctx, fee, gasLim, blobs, nil)
+}
+
+// SubmitPayForBlobsWithOptions is SubmitPayForBlobs customized with the given options, e.g. signed
+// with another keyring account or paid for by the fee granter.
+func (ca *CoreAccessor) SubmitPayForBlobsWithOptions(
+	ctx context.Context,
+	fee Int,
+	gasLim uint64,
+	blobs []*apptypes.Blob,
+	opts *TxOptions,
+) (*TxResponse, error) {
+	if len(blobs) == 0 {
+		return nil, errors.New("state: no blobs to pay for")
+	}
+	txs, err := ca.txManager(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the fee granter affects the gas consumed
+	builderOpts := opts.builderOptions()
+	if gasLim == 0 {
+		gasLim, err = ca.estimateGasForBlobs(ctx, txs, blobs, builderOpts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if fee.IsNil() || fee.IsZero() {
+		fee = ca.feeForGas(gasLim)
+	}
+
+	addr, err := txs.address()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := apptypes.NewMsgPayForBlobs(addr.String(), blobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := txs.submitMsg(ctx, msg, blobs, fee, gasLim, builderOpts...)
 	// metrics should only be counted on a successful PFD tx
 	if err == nil && response.Code == 0 {
 		ca.lastPayForBlob = time.Now().UnixMilli()
@@ -201,34 +257,15 @@ func (ca *CoreAccessor) BalanceForAddress(ctx context.Context, addr Address) (*B
 	if err != nil {
 		return nil, err
 	}
-	// construct an ABCI query for the height at head-1 because
-	// the AppHash contained in the head is actually the state root
-	// after applying the transactions contained in the previous block.
 	// TODO @renaynay: once https://github.com/cosmos/cosmos-sdk/pull/12674 is merged, use this method
 	// instead
 	prefixedAccountKey := append(banktypes.CreateAccountBalancesPrefix(addr.Bytes()), []byte(app.BondDenom)...)
-	abciReq := abci.RequestQuery{
-		// TODO @renayay: once https://github.com/cosmos/cosmos-sdk/pull/12674 is merged, use const instead
-		Path:   fmt.Sprintf("store/%s/key", banktypes.StoreKey),
-		Height: head.Height() - 1,
-		Data:   prefixedAccountKey,
-		Prove:  true,
-	}
-	opts := rpcclient.ABCIQueryOptions{
-		Height: abciReq.Height,
-		Prove:  abciReq.Prove,
-	}
-	result, err := ca.rpcCli.ABCIQueryWithOptions(ctx, abciReq.Path, abciReq.Data, opts)
+	value, err := ca.queryProven(ctx, head, banktypes.StoreKey, prefixedAccountKey)
 	if err != nil {
 		return nil, err
 	}
-	if !result.Response.IsOK() {
-		return nil, sdkErrorToGRPCError(result.Response)
-	}
-	// unmarshal balance information
-	value := result.Response.Value
 	// if the value returned is empty, the account balance does not yet exist
-	if len(value) == 0 {
+	if value == nil {
 		log.Errorf("balance for account %s does not exist at block height %d", addr.String(), head.Height()-1)
 		return &Balance{
 			Denom:  app.BondDenom,
@@ -239,29 +276,16 @@ func (ca *CoreAccessor) BalanceForAddress(ctx context.Context, addr Address) (*B
 	if !ok {
 		return nil, fmt.Errorf("cannot convert %s into sdktypes.Int", string(value))
 	}
-	// verify balance
-	err = ca.prt.VerifyValueFromKeys(
-		result.Response.GetProofOps(),
-		head.AppHash,
-		[][]byte{[]byte(banktypes.StoreKey),
-			prefixedAccountKey,
-		}, value)
-	if err != nil {
-		return nil, err
-	}
-
 	return &Balance{
 		Denom:  app.BondDenom,
 		Amount: coin,
 	}, nil
 }
 
+// SubmitTx broadcasts the given signed transaction and waits for its inclusion. The transaction
+// is rebroadcast if evicted from the mempool.
 func (ca *CoreAccessor) SubmitTx(ctx context.Context, tx Tx) (*TxResponse, error) {
-	txResp, err := apptypes.BroadcastTx(ctx, ca.coreConn, sdktx.BroadcastMode_BROADCAST_MODE_BLOCK, tx)
-	if err != nil {
-		return nil, err
-	}
-	return txResp.TxResponse, nil
+	return ca.txs[""].submitRaw(ctx, tx)
 }
 
 func (ca *CoreAccessor) SubmitTxWithBroadcastMode(
@@ -282,22 +306,34 @@ func (ca *CoreAccessor) Transfer(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.TransferWithOptions(ctx, addr, amount, fee, gasLim, nil)
+}
+
+// TransferWithOptions is Transfer customized with the given options.
+func (ca *CoreAccessor) TransferWithOptions(
+	ctx context.Context,
+	addr AccAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
+	txs, err := ca.txManager(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	coins := sdktypes.NewCoins(sdktypes.NewCoin(app.BondDenom, amount))
-	msg := banktypes.NewMsgSend(from, addr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+	from, err := txs.address()
 	if err != nil {
 		return nil, err
 	}
-	return ca.SubmitTx(ctx, signedTx)
+	coins := sdktypes.NewCoins(sdktypes.NewCoin(app.BondDenom, amount))
+	msg := banktypes.NewMsgSend(from, addr, coins)
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
 }
 
 func (ca *CoreAccessor) CancelUnbondingDelegation(
@@ -307,22 +343,35 @@ func (ca *CoreAccessor) CancelUnbondingDelegation(
 	height,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.CancelUnbondingDelegationWithOptions(ctx, valAddr, amount, height, fee, gasLim, nil)
+}
+
+// CancelUnbondingDelegationWithOptions is CancelUnbondingDelegation customized with the given options.
+func (ca *CoreAccessor) CancelUnbondingDelegationWithOptions(
+	ctx context.Context,
+	valAddr ValAddress,
+	amount,
+	height,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
+	txs, err := ca.txManager(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgCancelUnbondingDelegation(from, valAddr, height.Int64(), coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+	from, err := txs.address()
 	if err != nil {
 		return nil, err
 	}
-	return ca.SubmitTx(ctx, signedTx)
+	coins := sdktypes.NewCoin(app.BondDenom, amount)
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(from, valAddr, height.Int64(), coins)
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
 }
 
 func (ca *CoreAccessor) BeginRedelegate(
@@ -332,22 +381,35 @@ func (ca *CoreAccessor) BeginRedelegate(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.BeginRedelegateWithOptions(ctx, srcValAddr, dstValAddr, amount, fee, gasLim, nil)
+}
+
+// BeginRedelegateWithOptions is BeginRedelegate customized with the given options.
+func (ca *CoreAccessor) BeginRedelegateWithOptions(
+	ctx context.Context,
+	srcValAddr,
+	dstValAddr ValAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
+	txs, err := ca.txManager(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgBeginRedelegate(from, srcValAddr, dstValAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+	from, err := txs.address()
 	if err != nil {
 		return nil, err
 	}
-	return ca.SubmitTx(ctx, signedTx)
+	coins := sdktypes.NewCoin(app.BondDenom, amount)
+	msg := stakingtypes.NewMsgBeginRedelegate(from, srcValAddr, dstValAddr, coins)
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
 }
 
 func (ca *CoreAccessor) Undelegate(
@@ -356,22 +418,34 @@ func (ca *CoreAccessor) Undelegate(
 	amount,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.UndelegateWithOptions(ctx, delAddr, amount, fee, gasLim, nil)
+}
+
+// UndelegateWithOptions is Undelegate customized with the given options.
+func (ca *CoreAccessor) UndelegateWithOptions(
+	ctx context.Context,
+	delAddr ValAddress,
+	amount,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
+	txs, err := ca.txManager(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgUndelegate(from, delAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+	from, err := txs.address()
 	if err != nil {
 		return nil, err
 	}
-	return ca.SubmitTx(ctx, signedTx)
+	coins := sdktypes.NewCoin(app.BondDenom, amount)
+	msg := stakingtypes.NewMsgUndelegate(from, delAddr, coins)
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
 }
 
 func (ca *CoreAccessor) Delegate(
@@ -380,24 +454,38 @@ func (ca *CoreAccessor) Delegate(
 	amount Int,
 	fee Int,
 	gasLim uint64,
+) (*TxResponse, error) {
+	return ca.DelegateWithOptions(ctx, delAddr, amount, fee, gasLim, nil)
+}
+
+// DelegateWithOptions is Delegate customized with the given options.
+func (ca *CoreAccessor) DelegateWithOptions(
+	ctx context.Context,
+	delAddr ValAddress,
+	amount Int,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
 ) (*TxResponse, error) {
 	if amount.IsNil() || amount.Int64() <= 0 {
 		return nil, ErrInvalidAmount
 	}
 
-	from, err := ca.signer.GetSignerInfo().GetAddress()
+	txs, err := ca.txManager(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	coins := sdktypes.NewCoin(app.BondDenom, amount)
-	msg := stakingtypes.NewMsgDelegate(from, delAddr, coins)
-	signedTx, err := ca.constructSignedTx(ctx, msg, apptypes.SetGasLimit(gasLim), withFee(fee))
+	from, err := txs.address()
 	if err != nil {
 		return nil, err
 	}
-	return ca.SubmitTx(ctx, signedTx)
+	coins := sdktypes.NewCoin(app.BondDenom, amount)
+	msg := stakingtypes.NewMsgDelegate(from, delAddr, coins)
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
 }
 
+// QueryDelegation retrieves the delegation of the node's default account to the given validator
+// and verifies it against the AppHash of the head.
 func (ca *CoreAccessor) QueryDelegation(
 	ctx context.Context,
 	valAddr ValAddress,
@@ -406,12 +494,34 @@ func (ca *CoreAccessor) QueryDelegation(
 	if err != nil {
 		return nil, err
 	}
-	return ca.stakingCli.Delegation(ctx, &stakingtypes.QueryDelegationRequest{
-		DelegatorAddr: delAddr.String(),
-		ValidatorAddr: valAddr.String(),
-	})
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ca.queryProven(ctx, head, stakingtypes.StoreKey, stakingtypes.GetDelegationKey(delAddr, valAddr))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "delegation with delegator %s not found for validator %s",
+			delAddr, valAddr)
+	}
+	delegation, err := stakingtypes.UnmarshalDelegation(cdc, value)
+	if err != nil {
+		return nil, fmt.Errorf("state: unmarshalling delegation: %w", err)
+	}
+
+	val, err := ca.queryValidator(ctx, head, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	balance := sdktypes.NewCoin(app.BondDenom, val.TokensFromShares(delegation.Shares).TruncateInt())
+	resp := stakingtypes.NewDelegationResp(delAddr, valAddr, delegation.Shares, balance)
+	return &stakingtypes.QueryDelegationResponse{DelegationResponse: &resp}, nil
 }
 
+// QueryUnbonding retrieves the unbonding delegation of the node's default account from the given
+// validator and verifies it against the AppHash of the head.
 func (ca *CoreAccessor) QueryUnbonding(
 	ctx context.Context,
 	valAddr ValAddress,
@@ -420,11 +530,27 @@ func (ca *CoreAccessor) QueryUnbonding(
 	if err != nil {
 		return nil, err
 	}
-	return ca.stakingCli.UnbondingDelegation(ctx, &stakingtypes.QueryUnbondingDelegationRequest{
-		DelegatorAddr: delAddr.String(),
-		ValidatorAddr: valAddr.String(),
-	})
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ca.queryProven(ctx, head, stakingtypes.StoreKey, stakingtypes.GetUBDKey(delAddr, valAddr))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "unbonding delegation with delegator %s not found for validator %s",
+			delAddr, valAddr)
+	}
+	unbond, err := stakingtypes.UnmarshalUBD(cdc, value)
+	if err != nil {
+		return nil, fmt.Errorf("state: unmarshalling unbonding delegation: %w", err)
+	}
+	return &stakingtypes.QueryUnbondingDelegationResponse{Unbond: unbond}, nil
 }
+
+// QueryRedelegations retrieves the redelegation of the node's default account between the given
+// validators and verifies it against the AppHash of the head.
 func (ca *CoreAccessor) QueryRedelegations(
 	ctx context.Context,
 	srcValAddr,
@@ -434,11 +560,53 @@ func (ca *CoreAccessor) QueryRedelegations(
 	if err != nil {
 		return nil, err
 	}
-	return ca.stakingCli.Redelegations(ctx, &stakingtypes.QueryRedelegationsRequest{
-		DelegatorAddr:    delAddr.String(),
-		SrcValidatorAddr: srcValAddr.String(),
-		DstValidatorAddr: dstValAddr.String(),
-	})
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := stakingtypes.GetREDKey(delAddr, srcValAddr, dstValAddr)
+	value, err := ca.queryProven(ctx, head, stakingtypes.StoreKey, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "redelegation not found for delegator address %s "+
+			"from validator address %s", delAddr, srcValAddr)
+	}
+	red, err := stakingtypes.UnmarshalRED(cdc, value)
+	if err != nil {
+		return nil, fmt.Errorf("state: unmarshalling redelegation: %w", err)
+	}
+
+	// the balances of the entries are the tokens the shares are currently worth at the destination
+	val, err := ca.queryValidator(ctx, head, dstValAddr)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]stakingtypes.RedelegationEntryResponse, len(red.Entries))
+	for i, entry := range red.Entries {
+		entries[i] = stakingtypes.NewRedelegationEntryResponse(
+			entry.CreationHeight,
+			entry.CompletionTime,
+			entry.SharesDst,
+			entry.InitialBalance,
+			val.TokensFromShares(entry.SharesDst).TruncateInt(),
+		)
+	}
+	resp := stakingtypes.NewRedelegationResponse(delAddr, srcValAddr, dstValAddr, entries)
+	return &stakingtypes.QueryRedelegationsResponse{
+		RedelegationResponses: stakingtypes.RedelegationResponses{resp},
+	}, nil
+}
+
+// TxStatus returns the status of the transaction submitted under the given hash.
+func (ca *CoreAccessor) TxStatus(ctx context.Context, hash string) (*TxStatus, error) {
+	for _, txs := range ca.txs {
+		if status, ok := txs.tracked(hash); ok {
+			return status, nil
+		}
+	}
+	return ca.txs[""].status(ctx, hash)
 }
 
 func (ca *CoreAccessor) IsStopped(context.Context) bool {
@@ -0,0 +1,123 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+const (
+	// DefaultGasPrice is the default price in utia paid per unit of gas, when the fee is not given.
+	DefaultGasPrice = 0.1
+
+	// pfbGasFixedCost is a rough upper bound of the gas consumed by a PayForBlob transaction
+	// independently of the blobs it pays for (signature verification, tx size, etc.).
+	pfbGasFixedCost = 80000
+	// gasAdjustment is applied to the simulated gas, as the gas consumed on execution may slightly
+	// differ from the simulated one.
+	gasAdjustment = 1.1
+)
+
+// errNotStarted is returned when the CoreAccessor is used before it is started.
+var errNotStarted = errors.New("state: core accessor is not started")
+
+// Option is the functional option that is applied to the CoreAccessor.
+type Option func(*CoreAccessor)
+
+// WithGasPrice sets the price in utia paid per unit of gas, when the fee is not given.
+func WithGasPrice(gasPrice float64) Option {
+	return func(ca *CoreAccessor) {
+		ca.gasPrice = gasPrice
+	}
+}
+
+// EstimateGasForBlobs estimates the gas required to pay for the given blobs in a single PayForBlob
+// transaction. The gas charged by the blob module for the blob sizes is taken as the lower bound
+// for the gas simulated by the celestia-core endpoint. The simulated transaction is not signed, so
+// the estimation does not require the permission to sign with the default account.
+func (ca *CoreAccessor) EstimateGasForBlobs(ctx context.Context, blobs []*apptypes.Blob) (uint64, error) {
+	txs, ok := ca.txs[""]
+	if !ok {
+		return 0, errNotStarted
+	}
+	return ca.estimateGasForBlobs(ctx, txs, blobs)
+}
+
+// estimateGasForBlobs estimates the gas required by the PayForBlob transaction of the given
+// manager's account built with the given options, as the options (e.g. the fee granter) may
+// affect the gas consumed.
+func (ca *CoreAccessor) estimateGasForBlobs(
+	ctx context.Context,
+	txs *txManager,
+	blobs []*apptypes.Blob,
+	opts ...apptypes.TxBuilderOption,
+) (uint64, error) {
+	params, err := apptypes.NewQueryClient(ca.coreConn).Params(ctx, &apptypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	gas := estimateGasForBlobs(blobs, params.Params.GasPerBlobByte)
+
+	// the fee is deducted only if non-zero, so the transaction is simulated with the fee
+	// corresponding to the lower bound for the deduction to be accounted for
+	opts = append([]apptypes.TxBuilderOption{withFee(ca.feeForGas(gas))}, opts...)
+	simulated, err := ca.simulatePayForBlobs(ctx, txs, blobs, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("simulating PayForBlob: %w", err)
+	}
+	if adjusted := uint64(math.Ceil(float64(simulated) * gasAdjustment)); adjusted > gas {
+		return adjusted, nil
+	}
+	return gas, nil
+}
+
+// simulatePayForBlobs returns the gas consumed by the PayForBlob transaction paying for the given
+// blobs, as simulated by the celestia-core endpoint.
+func (ca *CoreAccessor) simulatePayForBlobs(
+	ctx context.Context,
+	txs *txManager,
+	blobs []*apptypes.Blob,
+	opts ...apptypes.TxBuilderOption,
+) (uint64, error) {
+	addr, err := txs.address()
+	if err != nil {
+		return 0, err
+	}
+	msg, err := apptypes.NewMsgPayForBlobs(addr.String(), blobs...)
+	if err != nil {
+		return 0, err
+	}
+	rawTx, err := txs.buildForSimulation(ctx, msg, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := sdktx.NewServiceClient(ca.coreConn).Simulate(ctx, &sdktx.SimulateRequest{TxBytes: rawTx})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GasInfo.GasUsed, nil
+}
+
+// feeForGas derives the fee paid for the given amount of gas from the configured gas price.
+func (ca *CoreAccessor) feeForGas(gas uint64) Int {
+	return sdktypes.NewInt(int64(math.Ceil(ca.gasPrice * float64(gas))))
+}
+
+// estimateGasForBlobs mirrors the gas consumption of the blob module in celestia-app, which
+// charges per byte of every share occupied by the blobs.
+func estimateGasForBlobs(blobs []*apptypes.Blob, gasPerBlobByte uint32) uint64 {
+	var sharesUsed uint64
+	for _, blob := range blobs {
+		sharesUsed += uint64(shares.SparseSharesNeeded(uint32(len(blob.Data))))
+	}
+	return sharesUsed*appconsts.ShareSize*uint64(gasPerBlobByte) + pfbGasFixedCost
+}
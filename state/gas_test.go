@@ -0,0 +1,28 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+func TestEstimateGasForBlobs(t *testing.T) {
+	blobs := []*apptypes.Blob{
+		{Data: make([]byte, 16)},
+		{Data: make([]byte, appconsts.ShareSize*2), ShareVersion: 1},
+	}
+	// the first blob fits a single share, while the second one spills over to the third share
+	expected := uint64(4*appconsts.ShareSize*appconsts.DefaultGasPerBlobByte + pfbGasFixedCost)
+	require.Equal(t, expected, estimateGasForBlobs(blobs, appconsts.DefaultGasPerBlobByte))
+	require.EqualValues(t, pfbGasFixedCost, estimateGasForBlobs(nil, appconsts.DefaultGasPerBlobByte))
+}
+
+func TestFeeForGas(t *testing.T) {
+	ca := NewCoreAccessor(nil, nil, "", "", "", WithGasPrice(0.15))
+	require.EqualValues(t, 15, ca.feeForGas(100).Int64())
+	// fractional fees are rounded up
+	require.EqualValues(t, 2, ca.feeForGas(7).Int64())
+}
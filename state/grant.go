@@ -0,0 +1,123 @@
+package state
+
+import (
+	"context"
+	"errors"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/celestiaorg/celestia-app/app"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// ErrNegativeSpendLimit is returned when the fee allowance is requested to be limited to a negative
+// amount.
+var ErrNegativeSpendLimit = errors.New("state: spend limit must not be negative")
+
+// payForBlobsTypeURL is the type of the messages the PayForBlob authorizations are granted for.
+var payForBlobsTypeURL = sdktypes.MsgTypeURL(&apptypes.MsgPayForBlobs{})
+
+// GrantFee grants the grantee an allowance to pay the fees of its transactions from the account
+// selected by the options, up to the given spend limit. Zero spend limit does not limit the
+// allowance.
+func (ca *CoreAccessor) GrantFee(
+	ctx context.Context,
+	grantee AccAddress,
+	spendLimit,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
+) (*TxResponse, error) {
+	if !spendLimit.IsNil() && spendLimit.IsNegative() {
+		return nil, ErrNegativeSpendLimit
+	}
+	txs, err := ca.txManager(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := txs.address()
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := &feegrant.BasicAllowance{}
+	if !spendLimit.IsNil() && spendLimit.IsPositive() {
+		allowance.SpendLimit = sdktypes.NewCoins(sdktypes.NewCoin(app.BondDenom, spendLimit))
+	}
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
+}
+
+// RevokeFee revokes the fee allowance granted to the grantee by the account selected by the
+// options.
+func (ca *CoreAccessor) RevokeFee(
+	ctx context.Context,
+	grantee AccAddress,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
+) (*TxResponse, error) {
+	txs, err := ca.txManager(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := txs.address()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter, grantee)
+	return txs.submitMsg(ctx, &msg, nil, fee, gasLim, opts.builderOptions()...)
+}
+
+// GrantPayForBlob authorizes the grantee to submit PayForBlob messages on behalf of the account
+// selected by the options. It requires the authz module to be enabled on the network.
+func (ca *CoreAccessor) GrantPayForBlob(
+	ctx context.Context,
+	grantee AccAddress,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
+) (*TxResponse, error) {
+	txs, err := ca.txManager(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := txs.address()
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(payForBlobsTypeURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	return txs.submitMsg(ctx, msg, nil, fee, gasLim, opts.builderOptions()...)
+}
+
+// RevokePayForBlob revokes the authorization to submit PayForBlob messages granted to the grantee
+// by the account selected by the options.
+func (ca *CoreAccessor) RevokePayForBlob(
+	ctx context.Context,
+	grantee AccAddress,
+	fee Int,
+	gasLim uint64,
+	opts *TxOptions,
+) (*TxResponse, error) {
+	txs, err := ca.txManager(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := txs.address()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := authz.NewMsgRevoke(granter, grantee, payForBlobsTypeURL)
+	return txs.submitMsg(ctx, &msg, nil, fee, gasLim, opts.builderOptions()...)
+}
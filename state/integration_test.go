@@ -3,8 +3,10 @@ package state
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,7 +16,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/testutil/testfactory"
@@ -50,7 +55,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.accounts = cfg.Accounts
 
 	signer := blobtypes.NewKeyringSigner(s.cctx.Keyring, s.accounts[0], s.cctx.ChainID)
-	accessor := NewCoreAccessor(signer, localHeader{s.cctx.Client}, "", "", "")
+	account := blobtypes.NewKeyringSigner(s.cctx.Keyring, s.accounts[1], s.cctx.ChainID)
+	accessor := NewCoreAccessor(signer, localHeader{s.cctx.Client}, "", "", "", WithAccounts(account))
 	setClients(accessor, s.cctx.GRPCClient, s.cctx.Client)
 	s.accessor = accessor
 
@@ -59,16 +65,14 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	require.NoError(s.T(), err)
 }
 
-func setClients(ca *CoreAccessor, conn *grpc.ClientConn, abciCli rpcclient.ABCIClient) {
+func setClients(ca *CoreAccessor, conn *grpc.ClientConn, rpcCli rpcclient.Client) {
 	ca.coreConn = conn
 	// create the query client
 	queryCli := banktypes.NewQueryClient(ca.coreConn)
 	ca.queryCli = queryCli
-	// create the staking query client
-	stakingCli := stakingtypes.NewQueryClient(ca.coreConn)
-	ca.stakingCli = stakingCli
 
-	ca.rpcCli = abciCli
+	ca.rpcCli = rpcCli
+	ca.startTxManagers(rpcCli)
 }
 
 func (s *IntegrationTestSuite) TearDownSuite() {
@@ -116,6 +120,149 @@ func (s *IntegrationTestSuite) TestGetBalance() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueries_Proven() {
+	require := s.Require()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	addr := s.getAddress(s.accounts[0]).(sdk.AccAddress)
+	acc, err := s.accessor.QueryAccount(ctx, addr)
+	require.NoError(err)
+	require.Equal(addr, acc.Address)
+
+	supply, err := s.accessor.TotalSupply(ctx)
+	require.NoError(err)
+	require.Equal(app.BondDenom, supply.Denom)
+	require.True(supply.Amount.IsPositive())
+
+	// the proven validator matches the one reported by the unverified endpoint
+	vals, err := stakingtypes.NewQueryClient(s.cctx.GRPCClient).Validators(ctx, &stakingtypes.QueryValidatorsRequest{})
+	require.NoError(err)
+	require.NotEmpty(vals.Validators)
+	valAddr, err := sdk.ValAddressFromBech32(vals.Validators[0].OperatorAddress)
+	require.NoError(err)
+	val, err := s.accessor.QueryValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(vals.Validators[0].OperatorAddress, val.Validator.OperatorAddress)
+	require.Equal(vals.Validators[0].Tokens, val.Validator.Tokens)
+
+	// absent values are proven to be absent
+	_, err = s.accessor.QueryUnbonding(ctx, valAddr)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	resp, err := s.accessor.Delegate(ctx, valAddr, sdk.NewInt(1000), sdk.NewInt(20000), 200000)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+	// the state including the delegation is committed to by the AppHash of the next block
+	require.NoError(s.cctx.WaitForNextBlock())
+
+	delegation, err := s.accessor.QueryDelegation(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(sdk.NewInt(1000), delegation.DelegationResponse.Balance.Amount)
+}
+
+func (s *IntegrationTestSuite) TestSubmitPayForBlobs_Concurrent() {
+	require := s.Require()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// concurrent submissions are signed with consecutive sequences instead of colliding
+	responses := make([]*TxResponse, 3)
+	errg, ctx := errgroup.WithContext(ctx)
+	for i := range responses {
+		i := i
+		errg.Go(func() error {
+			blob := &blobtypes.Blob{
+				NamespaceId: []byte{1, 2, 3, 4, 5, 6, 7, byte(i)},
+				Data:        []byte("data"),
+			}
+			resp, err := s.accessor.SubmitPayForBlobs(ctx, sdk.ZeroInt(), 0, []*blobtypes.Blob{blob})
+			responses[i] = resp
+			return err
+		})
+	}
+	require.NoError(errg.Wait())
+
+	for _, resp := range responses {
+		require.Equal(abci.CodeTypeOK, resp.Code)
+		require.NotZero(resp.Height)
+
+		status, err := s.accessor.TxStatus(ctx, resp.TxHash)
+		require.NoError(err)
+		require.Equal(TxCommitted, status.State)
+		require.Equal(resp.Height, status.Height)
+		require.Equal(1, status.Attempts)
+	}
+}
+
+func (s *IntegrationTestSuite) TestSubmitPayForBlobs_FeeGranter() {
+	require := s.Require()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	granter := s.getAddress(s.accounts[0]).(sdk.AccAddress)
+	grantee := s.getAddress(s.accounts[1]).(sdk.AccAddress)
+	fee := sdk.NewInt(20000)
+	blob := &blobtypes.Blob{NamespaceId: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Data: []byte("data")}
+
+	_, err := s.accessor.GrantFee(ctx, grantee, sdk.NewInt(-1), fee, 100000, nil)
+	require.ErrorIs(err, ErrNegativeSpendLimit)
+
+	resp, err := s.accessor.GrantFee(ctx, grantee, sdk.NewInt(1000000), fee, 100000, nil)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+
+	// the fee is paid by the granter from the allowance
+	opts := &TxOptions{Account: s.accounts[1], FeeGranter: granter}
+	resp, err = s.accessor.SubmitPayForBlobsWithOptions(ctx, sdk.ZeroInt(), 0, []*blobtypes.Blob{blob}, opts)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+
+	resp, err = s.accessor.RevokeFee(ctx, grantee, fee, 100000, nil)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+
+	_, err = s.accessor.SubmitPayForBlobsWithOptions(ctx, sdk.ZeroInt(), 0, []*blobtypes.Blob{blob}, opts)
+	require.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestTransfer_Account() {
+	require := s.Require()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	accounts, err := s.accessor.Accounts(ctx)
+	require.NoError(err)
+	require.Len(accounts, 2)
+	require.Equal(s.accounts[0], accounts[0].Name)
+	require.Equal(s.accounts[1], accounts[1].Name)
+
+	to := s.getAddress(s.accounts[0]).(sdk.AccAddress)
+	amount, fee := sdk.NewInt(1000), sdk.NewInt(20000)
+	_, err = s.accessor.TransferWithOptions(ctx, to, amount, fee, 100000, &TxOptions{Account: "unknown"})
+	require.ErrorIs(err, ErrUnknownAccount)
+
+	opts := &TxOptions{Account: s.accounts[1]}
+
+	s.accessor.guard = func(_ context.Context, account string) error {
+		if account != s.accounts[0] {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+	_, err = s.accessor.TransferWithOptions(ctx, to, amount, fee, 100000, opts)
+	require.EqualError(err, "forbidden")
+	// the default account is used without the options
+	resp, err := s.accessor.Transfer(ctx, to, amount, fee, 100000)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+	s.accessor.guard = nil
+
+	resp, err = s.accessor.TransferWithOptions(ctx, to, amount, fee, 100000, opts)
+	require.NoError(err)
+	require.Equal(abci.CodeTypeOK, resp.Code, resp.RawLog)
+}
+
 // This test can be used to generate a json encoded block for other test data,
 // such as that in share/availability/light/testdata
 func (s *IntegrationTestSuite) TestGenerateJSONBlock() {
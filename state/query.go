@@ -0,0 +1,159 @@
+package state
+
+import (
+	"context"
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+// cdc decodes the values of the application state.
+var cdc = encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec
+
+// AccountInfo is the on-chain information of an account.
+type AccountInfo struct {
+	Address       AccAddress `json:"address"`
+	AccountNumber uint64     `json:"account_number"`
+	Sequence      uint64     `json:"sequence"`
+}
+
+// QueryAccount retrieves the on-chain information of the account of the given address and
+// verifies it against the AppHash of the head.
+func (ca *CoreAccessor) QueryAccount(ctx context.Context, addr AccAddress) (*AccountInfo, error) {
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ca.queryProven(ctx, head, authtypes.StoreKey, authtypes.AddressStoreKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", addr)
+	}
+
+	var acc authtypes.AccountI
+	if err = cdc.UnmarshalInterface(value, &acc); err != nil {
+		return nil, fmt.Errorf("state: unmarshalling account %s: %w", addr, err)
+	}
+	return &AccountInfo{
+		Address:       acc.GetAddress(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}, nil
+}
+
+// TotalSupply retrieves the total supply of the Celestia coin and verifies it against the AppHash
+// of the head.
+func (ca *CoreAccessor) TotalSupply(ctx context.Context) (*Balance, error) {
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := append(banktypes.SupplyKey, []byte(app.BondDenom)...)
+	value, err := ca.queryProven(ctx, head, banktypes.StoreKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := sdktypes.NewCoin(app.BondDenom, sdktypes.ZeroInt())
+	if value == nil {
+		return &supply, nil
+	}
+	if err = supply.Amount.Unmarshal(value); err != nil {
+		return nil, fmt.Errorf("state: unmarshalling supply: %w", err)
+	}
+	return &supply, nil
+}
+
+// QueryValidator retrieves the validator of the given operator address and verifies it against
+// the AppHash of the head.
+func (ca *CoreAccessor) QueryValidator(
+	ctx context.Context,
+	valAddr ValAddress,
+) (*stakingtypes.QueryValidatorResponse, error) {
+	head, err := ca.getter.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	val, err := ca.queryValidator(ctx, head, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &stakingtypes.QueryValidatorResponse{Validator: *val}, nil
+}
+
+// queryValidator retrieves the validator of the given operator address at the given head.
+func (ca *CoreAccessor) queryValidator(
+	ctx context.Context,
+	head *header.ExtendedHeader,
+	valAddr ValAddress,
+) (*stakingtypes.Validator, error) {
+	value, err := ca.queryProven(ctx, head, stakingtypes.StoreKey, stakingtypes.GetValidatorKey(valAddr))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", valAddr)
+	}
+
+	val, err := stakingtypes.UnmarshalValidator(cdc, value)
+	if err != nil {
+		return nil, fmt.Errorf("state: unmarshalling validator %s: %w", valAddr, err)
+	}
+	return &val, nil
+}
+
+// queryProven retrieves the value stored under the given key in the store of the given module and
+// verifies it against the AppHash of the given head. The value is nil, if the key is proven to be
+// absent.
+//
+// NOTE: the value is queried at the height right before the head (head-1), as for block N, the
+// block's AppHash is the result of applying the previous block's transaction list.
+func (ca *CoreAccessor) queryProven(
+	ctx context.Context,
+	head *header.ExtendedHeader,
+	storeKey string,
+	key []byte,
+) ([]byte, error) {
+	opts := rpcclient.ABCIQueryOptions{
+		Height: head.Height() - 1,
+		Prove:  true,
+	}
+	// TODO @renayay: once https://github.com/cosmos/cosmos-sdk/pull/12674 is merged, use const instead
+	path := fmt.Sprintf("store/%s/key", storeKey)
+	result, err := ca.rpcCli.ABCIQueryWithOptions(ctx, path, key, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Response.IsOK() {
+		return nil, sdkErrorToGRPCError(result.Response)
+	}
+
+	keys := [][]byte{[]byte(storeKey), key}
+	value := result.Response.Value
+	if len(value) == 0 {
+		// verify the absence of the value
+		err = ca.prt.VerifyFromKeys(result.Response.GetProofOps(), head.AppHash, keys, nil)
+		if err != nil {
+			return nil, fmt.Errorf("state: verifying absence of the value in %s store: %w", storeKey, err)
+		}
+		return nil, nil
+	}
+	err = ca.prt.VerifyValueFromKeys(result.Response.GetProofOps(), head.AppHash, keys, value)
+	if err != nil {
+		return nil, fmt.Errorf("state: verifying the value in %s store: %w", storeKey, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,728 @@
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	lru "github.com/hashicorp/golang-lru"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+var (
+	// ErrTxNotFound is returned when the transaction is neither tracked nor included in a block.
+	ErrTxNotFound = errors.New("state: transaction not found")
+
+	// txPollInterval is the interval at which the inclusion of pending transactions is checked.
+	txPollInterval = time.Second * 2
+	// txResubmitTimeout is the time a transaction may stay pending before it is resubmitted.
+	txResubmitTimeout = time.Minute
+	// txMaxAttempts is the maximum amount of times a transaction is broadcast.
+	txMaxAttempts = 5
+	// maxUnconfirmedTxs is the maximum amount of transactions the node returns from its mempool.
+	maxUnconfirmedTxs = 100
+	// feeBumpPercent is the percentage the fee is raised by on every resubmission.
+	feeBumpPercent int64 = 20
+	// maxTrackedTxs is the amount of the most recent transactions whose status is kept.
+	maxTrackedTxs = 1024
+)
+
+// TxState is the state of a transaction submitted through the state service.
+type TxState string
+
+const (
+	// TxPending indicates the transaction is in the mempool, waiting to be included in a block.
+	TxPending TxState = "pending"
+	// TxCommitted indicates the transaction is included in a block.
+	TxCommitted TxState = "committed"
+	// TxFailed indicates the transaction was not included after all the submission attempts.
+	TxFailed TxState = "failed"
+)
+
+// TxStatus describes the progress of a transaction submitted through the state service.
+type TxStatus struct {
+	// Hash is the hash of the latest submission of the transaction. Resubmissions with a bumped fee
+	// change the hash, so the status can be requested by the hash of any submission.
+	Hash  string  `json:"hash"`
+	State TxState `json:"state"`
+	// Height, Code and Log describe the execution of the transaction, once committed.
+	Height int64  `json:"height,omitempty"`
+	Code   uint32 `json:"code"`
+	Log    string `json:"log,omitempty"`
+	// Attempts is the amount of times the transaction was accepted into the mempool.
+	Attempts int `json:"attempts"`
+	// Fee is the fee paid by the latest submission. It is unknown for transactions signed elsewhere.
+	Fee Int `json:"fee"`
+}
+
+// buildFn signs the transaction with the given sequence and fee.
+type buildFn func(sequence uint64, fee Int) ([]byte, error)
+
+// txClient looks up the transactions in the blocks and the mempool of the node.
+type txClient interface {
+	rpcclient.SignClient
+	rpcclient.MempoolClient
+}
+
+// txPresence tells whether a pending transaction, not included in a block, is still in the mempool.
+type txPresence int
+
+const (
+	// txUnknown indicates the transaction may or may not be in the mempool.
+	txUnknown txPresence = iota
+	// txInMempool indicates the transaction is still in the mempool.
+	txInMempool
+	// txGone indicates the transaction is neither in the mempool nor can be included anymore.
+	txGone
+)
+
+// resubmitResult is the outcome of rebroadcasting a pending transaction.
+type resubmitResult int
+
+const (
+	// resubmitFailed indicates the transaction could not be rebroadcast.
+	resubmitFailed resubmitResult = iota
+	// resubmitAccepted indicates the transaction was accepted into the mempool once more.
+	resubmitAccepted
+	// resubmitPending indicates the node reports the transaction is still in the mempool.
+	resubmitPending
+	// resubmitRejected indicates the node rejected the transaction.
+	resubmitRejected
+)
+
+// trackedTx is a transaction watched by the txManager until it is included in a block.
+type trackedTx struct {
+	// build is nil for transactions signed elsewhere, which can only be rebroadcast unchanged
+	build    buildFn
+	sequence uint64
+	raw      []byte
+	hashes   []string
+
+	status TxStatus
+	resp   *TxResponse
+	done   chan struct{}
+}
+
+// txManager signs and submits transactions on behalf of the signer's account. It tracks the
+// account sequence locally, so that concurrent submissions do not collide, and watches submitted
+// transactions until they are included, resubmitting them with a bumped fee if they are evicted
+// from the mempool.
+type txManager struct {
+	signer *apptypes.KeyringSigner
+	conn   *grpc.ClientConn
+	// rpcCli looks up the included transactions, as the gRPC endpoint cannot decode BlobTxs
+	rpcCli txClient
+
+	// signLk serializes signing and broadcasting, so that every transaction gets the next sequence
+	signLk   sync.Mutex
+	sequence uint64
+	synced   bool
+	// pending keeps the transactions signed by the manager by their sequence until they are done.
+	// It is guarded by signLk.
+	pending map[uint64]*trackedTx
+
+	// statusLk guards the tracked transactions
+	statusLk sync.Mutex
+	txs      *lru.Cache
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watchers sync.WaitGroup
+}
+
+func newTxManager(
+	signer *apptypes.KeyringSigner,
+	conn *grpc.ClientConn,
+	rpcCli txClient,
+) *txManager {
+	// the error is only returned on non-positive size
+	txs, _ := lru.New(maxTrackedTxs)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &txManager{
+		signer:  signer,
+		conn:    conn,
+		rpcCli:  rpcCli,
+		pending: make(map[uint64]*trackedTx),
+		txs:     txs,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// stop stops watching the pending transactions.
+func (m *txManager) stop() {
+	m.cancel()
+	m.watchers.Wait()
+}
+
+// submitMsg signs the given message along with the blobs it pays for, if any, and submits it. It
+// blocks until the transaction is included in a block.
+func (m *txManager) submitMsg(
+	ctx context.Context,
+	msg sdktypes.Msg,
+	blobs []*apptypes.Blob,
+	fee Int,
+	gasLim uint64,
+	opts ...apptypes.TxBuilderOption,
+) (*TxResponse, error) {
+	build := func(sequence uint64, fee Int) ([]byte, error) {
+		opts := append([]apptypes.TxBuilderOption{apptypes.SetGasLimit(gasLim), withFee(fee)}, opts...)
+		raw, err := m.sign(sequence, msg, opts...)
+		if err != nil || len(blobs) == 0 {
+			return raw, err
+		}
+		return coretypes.MarshalBlobTx(raw, blobs...)
+	}
+
+	m.signLk.Lock()
+	tx, resp, err := m.broadcastNew(ctx, build, fee)
+	m.signLk.Unlock()
+	if err != nil || tx == nil {
+		return resp, err
+	}
+	return m.waitForInclusion(ctx, tx)
+}
+
+// submitRaw submits the transaction signed elsewhere and blocks until it is included in a block.
+func (m *txManager) submitRaw(ctx context.Context, raw Tx) (*TxResponse, error) {
+	resp, err := m.broadcast(ctx, raw)
+	if err != nil || resp.Code != 0 {
+		return resp, err
+	}
+	tx := m.track(&trackedTx{raw: raw}, resp.TxHash, Int{})
+	return m.waitForInclusion(ctx, tx)
+}
+
+// buildForSimulation builds the transaction of the given message with the next sequence, without
+// consuming it. The transaction is left unsigned, as simulation does not verify signatures.
+func (m *txManager) buildForSimulation(
+	ctx context.Context,
+	msg sdktypes.Msg,
+	opts ...apptypes.TxBuilderOption,
+) ([]byte, error) {
+	m.signLk.Lock()
+	err := m.syncSequence(ctx, false)
+	sequence := m.sequence
+	m.signLk.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := m.signer.GetSignerInfo().GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	builder := m.signer.NewTxBuilder(opts...)
+	if err = builder.SetMsgs(msg); err != nil {
+		return nil, err
+	}
+	// the public key and the sequence are still required to account for the gas of verification
+	err = builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pub,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.signer.EncodeTx(builder.GetTx())
+}
+
+// address returns the address of the account the transactions are signed with.
+func (m *txManager) address() (AccAddress, error) {
+	return m.signer.GetSignerInfo().GetAddress()
+}
+
+// tracked returns the status of the transaction submitted under the given hash, if tracked.
+func (m *txManager) tracked(hash string) (*TxStatus, bool) {
+	v, ok := m.txs.Get(strings.ToUpper(hash))
+	if !ok {
+		return nil, false
+	}
+	m.statusLk.Lock()
+	defer m.statusLk.Unlock()
+	status := v.(*trackedTx).status
+	return &status, true
+}
+
+// status returns the status of the transaction submitted under the given hash.
+func (m *txManager) status(ctx context.Context, hash string) (*TxStatus, error) {
+	if status, ok := m.tracked(hash); ok {
+		return status, nil
+	}
+
+	// the transaction may have been submitted before the restart or by another client
+	resp, err := m.getTx(ctx, strings.ToUpper(hash))
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, ErrTxNotFound
+	}
+	return &TxStatus{
+		Hash:   resp.TxHash,
+		State:  TxCommitted,
+		Height: resp.Height,
+		Code:   resp.Code,
+		Log:    resp.RawLog,
+	}, nil
+}
+
+// broadcastNew signs the transaction with the next sequence and broadcasts it. The sequence is
+// synced once more if the node rejects it. The transaction is tracked only if accepted into the
+// mempool. It must be called under signLk.
+func (m *txManager) broadcastNew(ctx context.Context, build buildFn, fee Int) (*trackedTx, *TxResponse, error) {
+	if err := m.syncSequence(ctx, false); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		raw  []byte
+		resp *TxResponse
+		err  error
+	)
+	for resynced := false; ; resynced = true {
+		raw, err = build(m.sequence, fee)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err = m.broadcast(ctx, raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resynced || !isWrongSequence(resp) {
+			break
+		}
+		// the account was used by another client or a previous transaction was dropped
+		if err = m.syncSequence(ctx, true); err != nil {
+			return nil, nil, err
+		}
+	}
+	if resp.Code != 0 {
+		return nil, resp, nil
+	}
+
+	tx := m.track(&trackedTx{build: build, sequence: m.sequence, raw: raw}, resp.TxHash, fee)
+	m.pending[m.sequence] = tx
+	m.sequence++
+	return tx, resp, nil
+}
+
+// track starts watching the transaction accepted into the mempool under the given hash.
+func (m *txManager) track(tx *trackedTx, hash string, fee Int) *trackedTx {
+	tx.done = make(chan struct{})
+	tx.hashes = []string{hash}
+	tx.status = TxStatus{
+		Hash:     hash,
+		State:    TxPending,
+		Attempts: 1,
+		Fee:      fee,
+	}
+	m.txs.Add(hash, tx)
+	m.watchers.Add(1)
+	go m.watch(tx)
+	return tx
+}
+
+// waitForInclusion blocks until the transaction is included in a block. The transaction keeps
+// being watched if the context is canceled, so that its status can be requested later.
+func (m *txManager) waitForInclusion(ctx context.Context, tx *trackedTx) (*TxResponse, error) {
+	select {
+	case <-tx.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-m.ctx.Done():
+		return nil, errors.New("state: stopped watching transaction")
+	}
+
+	m.statusLk.Lock()
+	defer m.statusLk.Unlock()
+	if tx.status.State == TxFailed {
+		return nil, fmt.Errorf("state: transaction %s not included after %d attempts: %s",
+			tx.status.Hash, tx.status.Attempts, tx.status.Log)
+	}
+	return tx.resp, nil
+}
+
+// watch polls the node for any of the transaction submissions to be included in a block. If none
+// was included in time, the transaction is looked up in the mempool and rebroadcast unless it is
+// still there. It fails only once confirmed gone after all the attempts.
+func (m *txManager) watch(tx *trackedTx) {
+	defer m.watchers.Done()
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	resubmitAt := time.Now().Add(txResubmitTimeout)
+	// rejected counts the rebroadcasts rejected by the node, which are not accepted attempts
+	var rejected int
+	// confirming is set once the transaction is found gone, so that it is looked up once more
+	var confirming bool
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		m.statusLk.Lock()
+		hashes := append([]string(nil), tx.hashes...)
+		attempts := tx.status.Attempts
+		m.statusLk.Unlock()
+
+		for _, hash := range hashes {
+			resp, err := m.getTx(m.ctx, hash)
+			if err != nil {
+				log.Debugw("checking transaction inclusion", "hash", hash, "err", err)
+				continue
+			}
+			if resp != nil {
+				m.finish(tx, resp, TxCommitted, resp.RawLog)
+				m.untrack(tx)
+				return
+			}
+		}
+
+		if !confirming && time.Now().Before(resubmitAt) {
+			continue
+		}
+		presence := m.lookup(tx, hashes)
+		if presence == txGone && !confirming {
+			// the transaction is removed from the mempool before it is indexed in a block
+			confirming = true
+			continue
+		}
+		confirming = false
+		resubmitAt = time.Now().Add(txResubmitTimeout)
+
+		switch {
+		case presence == txInMempool:
+			// the transaction is still pending, so there is nothing to rebroadcast
+		case attempts+rejected < txMaxAttempts:
+			switch m.resubmit(tx) {
+			case resubmitRejected:
+				rejected++
+			case resubmitPending:
+				if presence == txGone {
+					// the sequence was used by another transaction
+					rejected++
+				}
+			}
+		case presence == txGone:
+			m.finish(tx, nil, TxFailed, "dropped from mempool")
+			m.resubmitQueued(tx)
+			return
+		}
+	}
+}
+
+// lookup tells whether the transaction under the given hashes is still in the mempool. The mempool
+// is known for sure only if the node returns it whole. Otherwise, the transactions signed by the
+// manager are known gone once their sequence is used.
+func (m *txManager) lookup(tx *trackedTx, hashes []string) txPresence {
+	limit := maxUnconfirmedTxs
+	res, err := m.rpcCli.UnconfirmedTxs(m.ctx, &limit)
+	if err != nil {
+		log.Errorw("looking up transaction in mempool", "hash", hashes[len(hashes)-1], "err", err)
+		return txUnknown
+	}
+	for _, raw := range res.Txs {
+		hash := fmt.Sprintf("%X", raw.Hash())
+		for _, h := range hashes {
+			if h == hash {
+				return txInMempool
+			}
+		}
+	}
+
+	if res.Total > res.Count {
+		if tx.build == nil {
+			return txUnknown
+		}
+		used, err := m.sequenceUsed(tx)
+		if err != nil {
+			log.Errorw("checking transaction sequence", "hash", hashes[len(hashes)-1], "err", err)
+			return txUnknown
+		}
+		if !used {
+			return txUnknown
+		}
+	}
+
+	// the transaction could have been included after the mempool was returned
+	for _, hash := range hashes {
+		resp, err := m.getTx(m.ctx, hash)
+		if err != nil || resp != nil {
+			return txUnknown
+		}
+	}
+	return txGone
+}
+
+// sequenceUsed reports whether the account sequence of the transaction signed by the manager was
+// used by a transaction included in a block.
+func (m *txManager) sequenceUsed(tx *trackedTx) (bool, error) {
+	m.signLk.Lock()
+	sequence := tx.sequence
+	m.signLk.Unlock()
+
+	addr, err := m.address()
+	if err != nil {
+		return false, err
+	}
+	res, err := authtypes.NewQueryClient(m.conn).Account(m.ctx, &authtypes.QueryAccountRequest{
+		Address: addr.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	var acc authtypes.AccountI
+	if err = cdc.UnpackAny(res.Account, &acc); err != nil {
+		return false, err
+	}
+	return acc.GetSequence() > sequence, nil
+}
+
+// resubmit broadcasts the transaction once more. Transactions signed by the manager are signed
+// again with the same sequence and a bumped fee, so that they replace the evicted submission. Only
+// the submissions accepted into the mempool count as attempts.
+func (m *txManager) resubmit(tx *trackedTx) resubmitResult {
+	m.statusLk.Lock()
+	raw, fee := tx.raw, tx.status.Fee
+	m.statusLk.Unlock()
+
+	m.signLk.Lock()
+	defer m.signLk.Unlock()
+
+	if tx.build != nil {
+		var err error
+		fee = bumpFee(fee)
+		raw, err = tx.build(tx.sequence, fee)
+		if err != nil {
+			log.Errorw("signing transaction for resubmission", "sequence", tx.sequence, "err", err)
+			return resubmitFailed
+		}
+	}
+
+	resp, err := m.broadcast(m.ctx, raw)
+	switch {
+	case err != nil:
+		log.Errorw("resubmitting transaction", "sequence", tx.sequence, "err", err)
+		return resubmitFailed
+	case resp.Code == 0:
+		// the previous submission was evicted and the new one replaces it
+		log.Infow("resubmitted transaction", "hash", resp.TxHash, "fee", fee)
+		m.statusLk.Lock()
+		tx.raw = raw
+		tx.hashes = append(tx.hashes, resp.TxHash)
+		tx.status.Hash = resp.TxHash
+		tx.status.Fee = fee
+		tx.status.Attempts++
+		m.statusLk.Unlock()
+		m.txs.Add(resp.TxHash, tx)
+		return resubmitAccepted
+	case isWrongSequence(resp), isInMempoolCache(resp):
+		// the previous submission is still in the mempool or has just been included
+		return resubmitPending
+	default:
+		log.Warnw("transaction resubmission rejected", "sequence", tx.sequence, "code", resp.Code,
+			"log", resp.RawLog)
+		return resubmitRejected
+	}
+}
+
+// resubmitQueued stops tracking the dropped transaction and signs the pending transactions queued
+// after it once more, reusing the sequence of the dropped one. Otherwise, they could never be
+// included because of the gap in the sequence.
+func (m *txManager) resubmitQueued(dropped *trackedTx) {
+	m.signLk.Lock()
+	defer m.signLk.Unlock()
+
+	if m.pending[dropped.sequence] == dropped {
+		delete(m.pending, dropped.sequence)
+	}
+	if err := m.syncSequence(m.ctx, true); err != nil {
+		m.synced = false
+		log.Errorw("syncing sequence after dropped transaction", "sequence", dropped.sequence, "err", err)
+		return
+	}
+	if m.sequence != dropped.sequence {
+		// the queued transactions are not blocked by the dropped one
+		return
+	}
+
+	queued := make([]uint64, 0, len(m.pending))
+	for sequence := range m.pending {
+		if sequence > dropped.sequence {
+			queued = append(queued, sequence)
+		}
+	}
+	sort.Slice(queued, func(i, j int) bool { return queued[i] < queued[j] })
+
+	for _, sequence := range queued {
+		tx := m.pending[sequence]
+		m.statusLk.Lock()
+		fee := tx.status.Fee
+		m.statusLk.Unlock()
+
+		raw, err := tx.build(m.sequence, fee)
+		if err != nil {
+			log.Errorw("signing queued transaction", "sequence", m.sequence, "err", err)
+			break
+		}
+		resp, err := m.broadcast(m.ctx, raw)
+		if err != nil {
+			log.Errorw("resubmitting queued transaction", "sequence", m.sequence, "err", err)
+			break
+		}
+		if resp.Code != 0 {
+			log.Warnw("queued transaction resubmission rejected", "sequence", m.sequence, "code", resp.Code,
+				"log", resp.RawLog)
+			break
+		}
+
+		log.Infow("resubmitted queued transaction", "hash", resp.TxHash, "sequence", m.sequence)
+		delete(m.pending, sequence)
+		tx.sequence = m.sequence
+		m.pending[tx.sequence] = tx
+		m.statusLk.Lock()
+		tx.raw = raw
+		tx.hashes = append(tx.hashes, resp.TxHash)
+		tx.status.Hash = resp.TxHash
+		tx.status.Attempts++
+		m.statusLk.Unlock()
+		m.txs.Add(resp.TxHash, tx)
+		m.sequence++
+	}
+	if m.sequence != dropped.sequence+uint64(len(queued)) {
+		// the sequence is synced again on the next submission
+		m.synced = false
+	}
+}
+
+// untrack stops tracking the sequence of the transaction included in a block.
+func (m *txManager) untrack(tx *trackedTx) {
+	m.signLk.Lock()
+	defer m.signLk.Unlock()
+	if tx.build != nil && m.pending[tx.sequence] == tx {
+		delete(m.pending, tx.sequence)
+	}
+}
+
+// finish marks the transaction done with the given state.
+func (m *txManager) finish(tx *trackedTx, resp *TxResponse, state TxState, log string) {
+	m.statusLk.Lock()
+	defer m.statusLk.Unlock()
+
+	tx.resp = resp
+	tx.status.State = state
+	tx.status.Log = log
+	if resp != nil {
+		tx.status.Hash = resp.TxHash
+		tx.status.Height = resp.Height
+		tx.status.Code = resp.Code
+	}
+	close(tx.done)
+}
+
+// syncSequence fetches the account number and sequence from the node, unless already synced or
+// forced. It must be called under signLk.
+func (m *txManager) syncSequence(ctx context.Context, force bool) error {
+	if m.synced && !force {
+		return nil
+	}
+
+	err := m.signer.QueryAccountNumber(ctx, m.conn)
+	if err != nil {
+		return err
+	}
+	data, err := m.signer.GetSignerData()
+	if err != nil {
+		return err
+	}
+	m.sequence = data.Sequence
+	m.synced = true
+	return nil
+}
+
+// sign signs the given message with the given sequence. It must be called under signLk.
+func (m *txManager) sign(
+	sequence uint64,
+	msg sdktypes.Msg,
+	opts ...apptypes.TxBuilderOption,
+) ([]byte, error) {
+	m.signer.SetSequence(sequence)
+	tx, err := m.signer.BuildSignedTx(m.signer.NewTxBuilder(opts...), msg)
+	if err != nil {
+		return nil, err
+	}
+	return m.signer.EncodeTx(tx)
+}
+
+// broadcast submits the transaction to the mempool, returning the result of CheckTx.
+func (m *txManager) broadcast(ctx context.Context, raw []byte) (*TxResponse, error) {
+	resp, err := apptypes.BroadcastTx(ctx, m.conn, sdktx.BroadcastMode_BROADCAST_MODE_SYNC, raw)
+	if err != nil {
+		return nil, err
+	}
+	return resp.TxResponse, nil
+}
+
+// getTx returns the response of the transaction included in a block, or nil if not included yet.
+func (m *txManager) getTx(ctx context.Context, hash string) (*TxResponse, error) {
+	bs, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("state: invalid transaction hash %s: %w", hash, err)
+	}
+	res, err := m.rpcCli.Tx(ctx, bs, false)
+	if err != nil {
+		if isTxNotFound(err, bs) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sdktypes.NewResponseResultTx(res, nil, ""), nil
+}
+
+// isTxNotFound reports whether the error is the one returned by the node for the transaction of the
+// given hash, which is not included in a block.
+func isTxNotFound(err error, hash []byte) bool {
+	notFound := fmt.Sprintf("tx (%X) not found", hash)
+	// the remote node wraps the error into the RPC error, while the local one returns it as is
+	var rpcErr *rpctypes.RPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Data == notFound
+	}
+	return err.Error() == notFound
+}
+
+// bumpFee raises the fee by feeBumpPercent, at least by one.
+func bumpFee(fee Int) Int {
+	bumped := fee.MulRaw(100 + feeBumpPercent).QuoRaw(100)
+	if bumped.LTE(fee) {
+		return fee.AddRaw(1)
+	}
+	return bumped
+}
+
+func isWrongSequence(resp *TxResponse) bool {
+	return resp.Codespace == sdkerrors.RootCodespace && resp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+func isInMempoolCache(resp *TxResponse) bool {
+	return resp.Codespace == sdkerrors.RootCodespace && resp.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
@@ -0,0 +1,510 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	apptypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+func TestBumpFee(t *testing.T) {
+	require.EqualValues(t, 1200, bumpFee(sdktypes.NewInt(1000)).Int64())
+	// fees too low to be raised by the percentage are raised by one
+	require.EqualValues(t, 4, bumpFee(sdktypes.NewInt(3)).Int64())
+	require.EqualValues(t, 1, bumpFee(sdktypes.NewInt(0)).Int64())
+}
+
+func TestIsTxNotFound(t *testing.T) {
+	hash := []byte{0xab, 0xcd}
+	notFound := &rpctypes.RPCError{Code: -32603, Message: "Internal error", Data: "tx (ABCD) not found"}
+	require.True(t, isTxNotFound(fmt.Errorf("response error: %w", notFound), hash))
+	// the local node returns the error as is
+	require.True(t, isTxNotFound(errors.New("tx (ABCD) not found"), hash))
+
+	// other transactions and transport errors are not mistaken for a pending transaction
+	require.False(t, isTxNotFound(errors.New("tx (ABCE) not found"), hash))
+	require.False(t, isTxNotFound(errors.New("post failed: 404 page not found"), hash))
+	other := &rpctypes.RPCError{Code: -32601, Message: "Method not found"}
+	require.False(t, isTxNotFound(fmt.Errorf("response error: %w", other), hash))
+}
+
+func TestTxManager_Inclusion(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+	node.setAutoCommit(true)
+
+	resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.EqualValues(t, 1, resp.Height)
+
+	status, err := m.status(ctx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, TxCommitted, status.State)
+	require.Equal(t, 1, status.Attempts)
+	require.EqualValues(t, 1000, status.Fee.Int64())
+
+	// transactions unknown to the manager are looked up on the node
+	m.txs.Purge()
+	status, err = m.status(ctx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, TxCommitted, status.State)
+	require.EqualValues(t, 1, status.Height)
+
+	_, err = m.status(ctx, "ABCD")
+	require.ErrorIs(t, err, ErrTxNotFound)
+}
+
+func TestTxManager_Eviction(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+
+	done := make(chan submitResult, 1)
+	go func() {
+		resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+		done <- submitResult{resp, err}
+	}()
+	first := node.waitMempool(t, 1)[0]
+	node.evict(first.hash)
+	node.setAutoCommit(true)
+
+	res := <-done
+	require.NoError(t, res.err)
+	resp := res.resp
+	require.NotEqual(t, first.hash, resp.TxHash)
+	// the transaction is signed once more with the same sequence and a bumped fee
+	included := node.broadcasted(resp.TxHash)
+	require.EqualValues(t, 0, included.sequence)
+	require.EqualValues(t, 1200, included.fee.Int64())
+
+	// the status is available by the hash of any submission
+	for _, hash := range []string{first.hash, resp.TxHash} {
+		status, ok := m.tracked(hash)
+		require.True(t, ok)
+		require.Equal(t, TxCommitted, status.State)
+		require.Equal(t, resp.TxHash, status.Hash)
+		require.Equal(t, 2, status.Attempts)
+		require.EqualValues(t, 1200, status.Fee.Int64())
+	}
+}
+
+func TestTxManager_FailedResubmitsQueued(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+	txMaxAttempts = 1
+
+	submit := func(results chan submitResult) {
+		resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+		results <- submitResult{resp, err}
+	}
+	dropped, queued := make(chan submitResult, 1), make(chan submitResult, 1)
+	go submit(dropped)
+	node.waitMempool(t, 1)
+	// give the dropped transaction a head start, so that it fails first
+	time.Sleep(txResubmitTimeout / 2)
+	go submit(queued)
+	first := node.waitMempool(t, 2)[0]
+
+	// the queued transaction cannot be included without the evicted one
+	node.evict(first.hash)
+	node.setAutoCommit(true)
+
+	res := <-dropped
+	require.Error(t, res.err)
+	status, ok := m.tracked(first.hash)
+	require.True(t, ok)
+	require.Equal(t, TxFailed, status.State)
+
+	// the queued transaction takes over the sequence of the failed one
+	res = <-queued
+	require.NoError(t, res.err)
+	require.EqualValues(t, 0, node.broadcasted(res.resp.TxHash).sequence)
+	status, ok = m.tracked(res.resp.TxHash)
+	require.True(t, ok)
+	require.Equal(t, TxCommitted, status.State)
+	require.Equal(t, 2, status.Attempts)
+
+	// the next transaction follows the resubmitted one
+	node.setAutoCommit(true)
+	resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, node.broadcasted(resp.TxHash).sequence)
+	waitUntracked(t, m)
+}
+
+func TestTxManager_StillPending(t *testing.T) {
+	for _, truncated := range []bool{false, true} {
+		t.Run(fmt.Sprintf("truncated=%t", truncated), func(t *testing.T) {
+			ctx, node, m := newTestTxManager(t)
+			txMaxAttempts = 2
+			// unless returned by the node, the mempool is probed with rebroadcasts, which are rejected
+			node.setTruncated(truncated)
+
+			done := make(chan submitResult, 1)
+			go func() {
+				resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+				done <- submitResult{resp, err}
+			}()
+			first := node.waitMempool(t, 1)[0]
+
+			// the transaction is not failed as long as it stays in the mempool
+			time.Sleep(txResubmitTimeout * 4)
+			status, ok := m.tracked(first.hash)
+			require.True(t, ok)
+			require.Equal(t, TxPending, status.State)
+			require.Equal(t, 1, status.Attempts)
+
+			node.setAutoCommit(true)
+			res := <-done
+			require.NoError(t, res.err)
+			require.Equal(t, first.hash, res.resp.TxHash)
+			status, ok = m.tracked(first.hash)
+			require.True(t, ok)
+			require.Equal(t, TxCommitted, status.State)
+			require.Equal(t, 1, status.Attempts)
+		})
+	}
+}
+
+func TestTxManager_SequenceUsed(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+	txMaxAttempts = 2
+	node.setTruncated(true)
+
+	done := make(chan submitResult, 1)
+	go func() {
+		resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+		done <- submitResult{resp, err}
+	}()
+	first := node.waitMempool(t, 1)[0]
+
+	// another client takes over the sequence of the evicted transaction
+	node.evict(first.hash)
+	node.useSequences(1)
+
+	res := <-done
+	require.Error(t, res.err)
+	status, ok := m.tracked(first.hash)
+	require.True(t, ok)
+	require.Equal(t, TxFailed, status.State)
+	require.Equal(t, 1, status.Attempts)
+	waitUntracked(t, m)
+}
+
+func TestTxManager_WrongSequence(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+	node.setAutoCommit(true)
+
+	resp, err := m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, node.broadcasted(resp.TxHash).sequence)
+
+	// another client submits transactions on behalf of the same account
+	node.useSequences(2)
+
+	resp, err = m.submitMsg(ctx, node.msg(), nil, sdktypes.NewInt(1000), 100000)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.EqualValues(t, 3, node.broadcasted(resp.TxHash).sequence)
+	require.EqualValues(t, 4, m.sequence)
+}
+
+func TestTxManager_SubmitRaw(t *testing.T) {
+	ctx, node, m := newTestTxManager(t)
+
+	m.signLk.Lock()
+	require.NoError(t, m.syncSequence(ctx, false))
+	raw, err := m.sign(m.sequence, node.msg(), withFee(sdktypes.NewInt(1000)))
+	m.signLk.Unlock()
+	require.NoError(t, err)
+
+	done := make(chan submitResult, 1)
+	go func() {
+		resp, err := m.submitRaw(ctx, raw)
+		done <- submitResult{resp, err}
+	}()
+	first := node.waitMempool(t, 1)[0]
+	node.evict(first.hash)
+	node.setAutoCommit(true)
+
+	// transactions signed elsewhere are rebroadcast unchanged
+	res := <-done
+	require.NoError(t, res.err)
+	resp := res.resp
+	require.Equal(t, first.hash, resp.TxHash)
+	status, ok := m.tracked(resp.TxHash)
+	require.True(t, ok)
+	require.Equal(t, TxCommitted, status.State)
+	require.Equal(t, 2, status.Attempts)
+	require.True(t, status.Fee.IsNil())
+	waitUntracked(t, m)
+}
+
+// waitUntracked waits for the manager to stop tracking the sequences of all the transactions.
+func waitUntracked(t *testing.T, m *txManager) {
+	require.Eventually(t, func() bool {
+		m.signLk.Lock()
+		defer m.signLk.Unlock()
+		return len(m.pending) == 0
+	}, time.Second*5, time.Millisecond)
+}
+
+type submitResult struct {
+	resp *TxResponse
+	err  error
+}
+
+// newTestTxManager returns the txManager submitting transactions to the fake node.
+func newTestTxManager(t *testing.T) (context.Context, *fakeNode, *txManager) {
+	pollInterval, resubmitTimeout, maxAttempts := txPollInterval, txResubmitTimeout, txMaxAttempts
+	txPollInterval, txResubmitTimeout = time.Millisecond*5, time.Millisecond*200
+	t.Cleanup(func() {
+		txPollInterval, txResubmitTimeout, txMaxAttempts = pollInterval, resubmitTimeout, maxAttempts
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := apptypes.NewKeyringSigner(keyring.NewInMemory(encCfg.Codec), "test", "private")
+	rec, _, err := signer.NewMnemonic("test", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := rec.GetAddress()
+	require.NoError(t, err)
+
+	node := &fakeNode{
+		addr:       addr,
+		decoder:    encCfg.TxConfig.TxDecoder(),
+		broadcasts: make(map[string]*fakeTx),
+		included:   make(map[string]int64),
+	}
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	sdktx.RegisterServiceServer(srv, node)
+	authtypes.RegisterQueryServer(srv, node)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	m := newTxManager(signer, conn, node)
+	t.Cleanup(m.stop)
+	return ctx, node, m
+}
+
+// fakeTx is a transaction broadcast to the fakeNode.
+type fakeTx struct {
+	hash     string
+	raw      []byte
+	sequence uint64
+	fee      Int
+}
+
+// fakeNode imitates the node of a single account. It accepts transactions with the next sequence
+// into the mempool and includes them in a block on demand.
+type fakeNode struct {
+	sdktx.UnimplementedServiceServer
+	authtypes.UnimplementedQueryServer
+	// the clients are embedded to satisfy txClient, only Tx and UnconfirmedTxs are implemented
+	rpcclient.SignClient
+	rpcclient.MempoolClient
+
+	addr    AccAddress
+	decoder sdktypes.TxDecoder
+
+	lk         sync.Mutex
+	sequence   uint64
+	height     int64
+	mempool    []*fakeTx
+	broadcasts map[string]*fakeTx
+	included   map[string]int64
+	autoCommit bool
+	// truncated makes the node return none of the mempool transactions
+	truncated bool
+}
+
+func (n *fakeNode) msg() sdktypes.Msg {
+	return banktypes.NewMsgSend(n.addr, n.addr, sdktypes.NewCoins(sdktypes.NewInt64Coin(app.BondDenom, 1)))
+}
+
+func (n *fakeNode) Account(
+	context.Context,
+	*authtypes.QueryAccountRequest,
+) (*authtypes.QueryAccountResponse, error) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	acc, err := codectypes.NewAnyWithValue(&authtypes.BaseAccount{
+		Address:       n.addr.String(),
+		AccountNumber: 1,
+		Sequence:      n.sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: acc}, nil
+}
+
+func (n *fakeNode) BroadcastTx(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	tx, err := n.decoder(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	ftx := &fakeTx{
+		hash:     fmt.Sprintf("%X", tmtypes.Tx(req.TxBytes).Hash()),
+		raw:      req.TxBytes,
+		sequence: sigs[0].Sequence,
+		fee:      tx.(sdktypes.FeeTx).GetFee().AmountOf(app.BondDenom),
+	}
+	if next := n.sequence + uint64(len(n.mempool)); ftx.sequence != next {
+		err := sdkerrors.ErrWrongSequence.Wrapf("account sequence mismatch, expected %d, got %d", next, ftx.sequence)
+		codespace, code, log := sdkerrors.ABCIInfo(err, false)
+		return &sdktx.BroadcastTxResponse{
+			TxResponse: &sdktypes.TxResponse{Codespace: codespace, Code: code, RawLog: log},
+		}, nil
+	}
+
+	n.broadcasts[ftx.hash] = ftx
+	n.mempool = append(n.mempool, ftx)
+	if n.autoCommit {
+		n.commit()
+	}
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{TxHash: ftx.hash}}, nil
+}
+
+func (n *fakeNode) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	height, ok := n.included[fmt.Sprintf("%X", hash)]
+	if !ok {
+		// mimics the error returned by the remote node
+		return nil, fmt.Errorf("response error: %w", &rpctypes.RPCError{
+			Code:    -32603,
+			Message: "Internal error",
+			Data:    fmt.Sprintf("tx (%X) not found", hash),
+		})
+	}
+	return &coretypes.ResultTx{
+		Hash:     hash,
+		Height:   height,
+		TxResult: abci.ResponseDeliverTx{Log: "[]"},
+	}, nil
+}
+
+func (n *fakeNode) UnconfirmedTxs(context.Context, *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	res := &coretypes.ResultUnconfirmedTxs{Total: len(n.mempool)}
+	if n.truncated {
+		return res, nil
+	}
+	for _, tx := range n.mempool {
+		res.Txs = append(res.Txs, tx.raw)
+	}
+	res.Count = len(res.Txs)
+	return res, nil
+}
+
+func (n *fakeNode) setTruncated(truncated bool) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	n.truncated = truncated
+}
+
+// setAutoCommit includes the mempool and all the transactions accepted later in a block.
+func (n *fakeNode) setAutoCommit(auto bool) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	n.autoCommit = auto
+	if auto {
+		n.commit()
+	}
+}
+
+func (n *fakeNode) commit() {
+	if len(n.mempool) == 0 {
+		return
+	}
+	n.height++
+	for _, tx := range n.mempool {
+		n.included[tx.hash] = n.height
+	}
+	n.sequence += uint64(len(n.mempool))
+	n.mempool = nil
+}
+
+// evict drops the transaction from the mempool along with the following ones, which fail the
+// recheck because of the gap in the sequence.
+func (n *fakeNode) evict(hash string) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	for i, tx := range n.mempool {
+		if tx.hash == hash {
+			n.mempool = n.mempool[:i]
+			return
+		}
+	}
+}
+
+// useSequences imitates another client including the given amount of transactions.
+func (n *fakeNode) useSequences(amount uint64) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	n.sequence += amount
+}
+
+func (n *fakeNode) broadcasted(hash string) *fakeTx {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+	return n.broadcasts[hash]
+}
+
+// waitMempool waits for the given amount of transactions in the mempool.
+func (n *fakeNode) waitMempool(t *testing.T, amount int) []*fakeTx {
+	var mempool []*fakeTx
+	require.Eventually(t, func() bool {
+		n.lk.Lock()
+		defer n.lk.Unlock()
+		mempool = append([]*fakeTx(nil), n.mempool...)
+		return len(mempool) == amount
+	}, time.Second*5, time.Millisecond)
+	return mempool
+}